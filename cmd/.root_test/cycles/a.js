@@ -0,0 +1,4 @@
+import { b } from './b'
+import { c } from './c'
+
+export const a = b + c
@@ -13,36 +13,76 @@ import (
 )
 
 func CheckCmd(cfgF func() (*config.Config, error)) *cobra.Command {
-	return &cobra.Command{
+	var watchFiles bool
+	var maxCycles int
+	var granularity language.Granularity
+
+	cmd := &cobra.Command{
 		Use:     "check",
 		Short:   "Checks that the dependency rules defined in the configuration file are not broken",
 		GroupID: checkGroupId,
 		Args:    cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cfg, err := cfgF()
-			if err != nil {
+			if err := granularity.Validate(); err != nil {
 				return err
 			}
-			if cfg.Source == "default" {
-				return errors.New("when using the `check` subcommand, a .dep-tree.yml file must be provided, you can create one sample .dep-tree.yml file executing `dep-tree config` in your terminal")
-			}
+			run := func() error {
+				cfg, err := cfgF()
+				if err != nil {
+					return err
+				}
+				if cfg.Source == "default" {
+					return errors.New("when using the `check` subcommand, a .dep-tree.yml file must be provided, you can create one sample .dep-tree.yml file executing `dep-tree config` in your terminal")
+				}
+
+				if len(cfg.Check.Entrypoints) == 0 {
+					return fmt.Errorf(`config file "%s" has no entrypoints`, cfg.Path)
+				}
+				cfg.Check.MaxCycles = maxCycles
+				lang, _, err := inferLang(cfg.Check.Entrypoints, cfg)
+				if err != nil {
+					return err
+				}
+				defer closeLang(lang)
+				parser := language.NewParser(lang)
+				applyConfigToParser(parser, cfg)
 
-			if len(cfg.Check.Entrypoints) == 0 {
-				return fmt.Errorf(`config file "%s" has no entrypoints`, cfg.Path)
+				if !watchFiles && granularity.Level == language.FileLevel {
+					return check.Check[*language.FileInfo](
+						parser,
+						relPathDisplay,
+						&cfg.Check,
+						graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay),
+					)
+				} else if !watchFiles {
+					collapsed, _, err := loadCollapsed(cfg.Check.EntrypointPaths(), parser, granularity, graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay))
+					if err != nil {
+						return err
+					}
+					return check.Validate(collapsed, relPathDisplay, &cfg.Check)
+				}
+				return watchGraph(cmd.Context(), cfg.Check.EntrypointPaths(), parser, cfg, func(g *graph.Graph[*language.FileInfo]) error {
+					collapsed, _, err := collapse(g, nil, granularity)
+					if err != nil {
+						return err
+					}
+					err = check.Validate(collapsed, relPathDisplay, &cfg.Check)
+					if err == nil {
+						cmd.Println("Check passed")
+					}
+					return err
+				})
 			}
-			lang, err := inferLang(cfg.Check.Entrypoints, cfg)
-			if err != nil {
-				return err
+			if watchFiles {
+				return restartOnConfigChange(run)
 			}
-			parser := language.NewParser(lang)
-			applyConfigToParser(parser, cfg)
-
-			return check.Check[*language.FileInfo](
-				parser,
-				relPathDisplay,
-				&cfg.Check,
-				graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay),
-			)
+			return run()
 		},
 	}
+
+	cmd.Flags().BoolVar(&watchFiles, "watch", false, "keep checking the rules each time a file changes")
+	cmd.Flags().IntVar(&maxCycles, "max-cycles", 1, "maximum amount of circular dependencies shown for each group of files that depend on each other")
+	addGranularityFlags(cmd, &granularity)
+
+	return cmd
 }
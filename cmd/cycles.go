@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/config"
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/spf13/cobra"
+)
+
+func CyclesCmd(cfgF func() (*config.Config, error)) *cobra.Command {
+	var suggestCuts bool
+	var maxCycles int
+
+	cmd := &cobra.Command{
+		Use:     "cycles",
+		Short:   "Shows the groups of files that depend on each other, and which imports can be cut for untangling them",
+		GroupID: checkGroupId,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := filesFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := cfgF()
+			if err != nil {
+				return err
+			}
+
+			lang, files, err := inferLang(files, cfg)
+			if err != nil {
+				return err
+			}
+			defer closeLang(lang)
+
+			parser := language.NewParser(lang)
+			applyConfigToParser(parser, cfg)
+
+			g := graph.NewGraph[*language.FileInfo]()
+			err = g.Load(files, parser, graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay))
+			if err != nil {
+				return err
+			}
+
+			if suggestCuts {
+				for _, line := range renderCuts(g, parser) {
+					cmd.Println(line)
+				}
+				return nil
+			}
+
+			tangles := g.Tangles(maxCycles)
+			if len(tangles) == 0 {
+				cmd.Println("No circular dependencies found")
+			}
+			for _, tangle := range tangles {
+				cmd.Printf("%d files depend on each other: %s\n", len(tangle.Nodes), strings.Join(displayIds(g, tangle.Nodes), ", "))
+				for _, cycle := range tangle.Cycles {
+					cmd.Println("  " + strings.Join(displayCycle(g, cycle.Stack), " -> "))
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&suggestCuts, "suggest-cuts", false, "suggest the imports that should be cut for removing all the circular dependencies, trying to cut as few imported symbols as possible")
+	cmd.Flags().IntVar(&maxCycles, "max-cycles", 1, "maximum amount of circular dependencies shown for each group of files that depend on each other")
+
+	return cmd
+}
+
+// renderCuts suggests which imports to cut, weighting each one of them by the amount
+// of symbols that it imports, as those are the ones that would need to be moved around.
+func renderCuts(g *graph.Graph[*language.FileInfo], parser *language.Parser) []string {
+	cuts := g.FeedbackArcSet(func(from, to *graph.Node[*language.FileInfo]) int {
+		weight, err := parser.ImportWeight(to.Id, g.EdgeData(from.Id, to.Id))
+		if err != nil {
+			return 1
+		}
+		return weight
+	})
+	if len(cuts) == 0 {
+		return []string{"No circular dependencies found"}
+	}
+
+	lines := []string{fmt.Sprintf("Cutting these %d imports removes all the circular dependencies:", len(cuts))}
+	for _, cut := range cuts {
+		lines = append(lines, "- "+displayEdge(g, cut[0], cut[1]))
+	}
+	return lines
+}
+
+// displayEdge renders an edge like "a.ts:12 -> b.ts (imports foo, bar)", telling where
+// and what a file imports from the other, if it's known.
+func displayEdge(g *graph.Graph[*language.FileInfo], from, to string) string {
+	data := g.EdgeData(from, to)
+	ids := displayIds(g, []string{from, to})
+	if line := data.Line(); line > 0 {
+		ids[0] += fmt.Sprintf(":%d", line)
+	}
+	result := ids[0] + " -> " + ids[1]
+	if description := data.Describe(); description != "" {
+		result += " (" + description + ")"
+	}
+	return result
+}
+
+// displayCycle renders the files in a cycle, each one followed by the line where it
+// imports the next one.
+func displayCycle(g *graph.Graph[*language.FileInfo], stack []string) []string {
+	result := displayIds(g, stack)
+	for i := 0; i+1 < len(stack); i++ {
+		if line := g.EdgeData(stack[i], stack[i+1]).Line(); line > 0 {
+			result[i] += fmt.Sprintf(":%d", line)
+		}
+	}
+	return result
+}
+
+func displayIds(g *graph.Graph[*language.FileInfo], ids []string) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		if node := g.Get(id); node != nil {
+			result[i] = relPathDisplay(node)
+		} else {
+			result[i] = id
+		}
+	}
+	return result
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/spf13/cobra"
+
+	"github.com/gabotechs/dep-tree/internal/config"
+	"github.com/gabotechs/dep-tree/internal/diff"
+	"github.com/gabotechs/dep-tree/internal/entropy"
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func DiffCmd(cfgF func() (*config.Config, error)) *cobra.Command {
+	var jsonFormat bool
+	var renderEntropy bool
+	var noBrowserOpen bool
+	var renderPath string
+
+	cmd := &cobra.Command{
+		Use:     "diff <rev-a> <rev-b> <entrypoints...>",
+		Short:   "Shows how the dependency graph changed between two git revisions",
+		GroupID: explainGroupId,
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if jsonFormat && renderEntropy {
+				return errors.New("only one of --json or --entropy can be used at a time")
+			}
+			cwd, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+			repo, err := git.PlainOpenWithOptions(cwd, &git.PlainOpenOptions{DetectDotGit: true})
+			if err != nil {
+				return fmt.Errorf("could not open the git repository: %w", err)
+			}
+			worktree, err := repo.Worktree()
+			if err != nil {
+				return err
+			}
+			root := worktree.Filesystem.Root()
+
+			cfg, err := cfgF()
+			if err != nil {
+				return err
+			}
+
+			revisions := make([]*diff.Revision, 2)
+			for i, rev := range args[:2] {
+				dir, err := os.MkdirTemp("", "dep-tree-diff-")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(dir)
+				revisions[i], err = loadRevision(repo, rev, root, dir, args[2:], cfg)
+				if err != nil {
+					return err
+				}
+			}
+			if len(revisions[0].Entrypoints) == 0 && len(revisions[1].Entrypoints) == 0 {
+				return fmt.Errorf("%s does not match with any file in %s or %s", strings.Join(args[2:], ", "), args[0], args[1])
+			}
+
+			if renderEntropy {
+				g, entrypoints, status, err := diff.Union(revisions[0], revisions[1])
+				if err != nil {
+					return err
+				}
+				return entropy.RenderDiff(g, entrypoints, status, entropy.RenderConfig{
+					NoOpen:     noBrowserOpen,
+					RenderPath: renderPath,
+				})
+			}
+
+			result, err := diff.Compute(revisions[0], revisions[1])
+			if err != nil {
+				return err
+			}
+			if jsonFormat {
+				rendered, err := json.MarshalIndent(result, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(rendered))
+			} else {
+				cmd.Print(result.Render())
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonFormat, "json", false, "render the changes in a machine readable json format")
+	cmd.Flags().BoolVar(&renderEntropy, "entropy", false, "render the changes in a 3d force-directed graph in the browser, coloring the added and removed dependencies")
+	cmd.Flags().BoolVar(&noBrowserOpen, "no-browser-open", false, "with --entropy, disable the automatic browser open")
+	cmd.Flags().StringVar(&renderPath, "render-path", "", "with --entropy, sets the output path of the rendered html file")
+
+	return cmd
+}
+
+// loadRevision extracts the files of the revision from the git object store into dir, and
+// loads the dependency graph there, as if the repository's root was dir. Entrypoints that do
+// not exist in the revision are ignored.
+func loadRevision(
+	repo *git.Repository,
+	rev string,
+	root string,
+	dir string,
+	patterns []string,
+	cfg *config.Config,
+) (*diff.Revision, error) {
+	if err := diff.Extract(repo, rev, dir); err != nil {
+		return nil, err
+	}
+	revCfg := cfg.Rebase(root, dir)
+	revCfg.Cache.Enabled = false
+
+	var entrypoints []string
+	for _, pattern := range patterns {
+		abs, err := filepath.Abs(pattern)
+		if err != nil {
+			return nil, err
+		}
+		rel, err := filepath.Rel(root, abs)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			return nil, fmt.Errorf("%s is not inside the git repository", pattern)
+		}
+		files, err := filesFromArgs([]string{filepath.Join(dir, rel)})
+		if err == nil {
+			entrypoints = append(entrypoints, files...)
+		}
+	}
+
+	result := &diff.Revision{
+		Name:        rev,
+		Dir:         dir,
+		Graph:       graph.NewGraph[*language.FileInfo](),
+		Entrypoints: entrypoints,
+	}
+	if cfg.Source == "file" {
+		result.Check = &revCfg.Check
+	}
+	if len(entrypoints) == 0 {
+		return result, nil
+	}
+
+	lang, entrypoints, err := inferLang(entrypoints, revCfg)
+	if err != nil {
+		return nil, err
+	}
+	defer closeLang(lang)
+	parser := language.NewParser(lang)
+	applyConfigToParser(parser, revCfg)
+	err = result.Graph.Load(entrypoints, parser, graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay))
+	return result, err
+}
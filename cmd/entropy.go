@@ -13,6 +13,8 @@ func EntropyCmd(cfgF func() (*config.Config, error)) *cobra.Command {
 	var noBrowserOpen bool
 	var enableGui bool
 	var renderPath string
+	var watchFiles bool
+	var granularity language.Granularity
 
 	cmd := &cobra.Command{
 		Use:     "entropy",
@@ -20,34 +22,64 @@ func EntropyCmd(cfgF func() (*config.Config, error)) *cobra.Command {
 		GroupID: renderGroupId,
 		Args:    cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			files, err := filesFromArgs(args)
-			if err != nil {
+			if err := granularity.Validate(); err != nil {
 				return err
 			}
-			cfg, err := cfgF()
-			if err != nil {
-				return err
+			run := func() error {
+				files, err := filesFromArgs(args)
+				if err != nil {
+					return err
+				}
+				cfg, err := cfgF()
+				if err != nil {
+					return err
+				}
+				lang, files, err := inferLang(files, cfg)
+				if err != nil {
+					return err
+				}
+				defer closeLang(lang)
+				parser := language.NewParser(lang)
+				applyConfigToParser(parser, cfg)
+
+				renderCfg := entropy.RenderConfig{
+					NoOpen:        noBrowserOpen,
+					EnableGui:     enableGui,
+					LoadCallbacks: graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay),
+					RenderPath:    renderPath,
+				}
+				if !watchFiles && granularity.Level == language.FileLevel {
+					return entropy.Render(files, parser, renderCfg)
+				} else if !watchFiles {
+					collapsed, ids, err := loadCollapsed(files, parser, granularity, renderCfg.LoadCallbacks)
+					if err != nil {
+						return err
+					}
+					return entropy.RenderGraph(collapsed, ids, renderCfg)
+				}
+				return watchGraph(cmd.Context(), files, parser, cfg, func(g *graph.Graph[*language.FileInfo]) error {
+					collapsed, ids, err := collapse(g, files, granularity)
+					if err != nil {
+						return err
+					}
+					err = entropy.RenderGraph(collapsed, ids, renderCfg)
+					// the browser is only opened the first time, then it just needs to be reloaded.
+					renderCfg.NoOpen = true
+					return err
+				})
 			}
-			lang, err := inferLang(files, cfg)
-			if err != nil {
-				return err
+			if watchFiles {
+				return restartOnConfigChange(run)
 			}
-			parser := language.NewParser(lang)
-			applyConfigToParser(parser, cfg)
-
-			err = entropy.Render(files, parser, entropy.RenderConfig{
-				NoOpen:        noBrowserOpen,
-				EnableGui:     enableGui,
-				LoadCallbacks: graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay),
-				RenderPath:    renderPath,
-			})
-			return err
+			return run()
 		},
 	}
 
 	cmd.Flags().BoolVar(&noBrowserOpen, "no-browser-open", false, "Disable the automatic browser open while rendering entropy")
 	cmd.Flags().BoolVar(&enableGui, "enable-gui", false, "Enables a GUI for changing rendering settings")
 	cmd.Flags().StringVar(&renderPath, "render-path", "", "Sets the output path of the rendered html file")
+	cmd.Flags().BoolVar(&watchFiles, "watch", false, "Renders the graph again each time a file changes")
+	addGranularityFlags(cmd, &granularity)
 
 	return cmd
 }
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"slices"
 	"strings"
@@ -17,6 +18,7 @@ import (
 func ExplainCmd(cfgF func() (*config.Config, error)) *cobra.Command {
 	var overlapLeft bool
 	var overlapRight bool
+	var granularity language.Granularity
 
 	cmd := &cobra.Command{
 		Use:     "explain",
@@ -27,6 +29,9 @@ func ExplainCmd(cfgF func() (*config.Config, error)) *cobra.Command {
 			if overlapLeft && overlapRight {
 				return errors.New("only one of --overlap-left (-l) or --overlap-right (-r) can be used at a time")
 			}
+			if err := granularity.Validate(); err != nil {
+				return err
+			}
 
 			fromFiles, err := filesFromArgs([]string{args[0]})
 			if err != nil {
@@ -51,10 +56,11 @@ func ExplainCmd(cfgF func() (*config.Config, error)) *cobra.Command {
 				return err
 			}
 
-			lang, err := inferLang(fromFiles, cfg)
+			lang, fromFiles, err := inferLang(fromFiles, cfg)
 			if err != nil {
 				return err
 			}
+			defer closeLang(lang)
 
 			parser := language.NewParser(lang)
 			applyConfigToParser(parser, cfg)
@@ -64,33 +70,47 @@ func ExplainCmd(cfgF func() (*config.Config, error)) *cobra.Command {
 			tempCfg.EnsureAbsPaths()
 			parser.Include = tempCfg.Only
 
+			var nodeParser graph.NodeParser[*language.FileInfo] = parser
+			var callbacks graph.LoadCallbacks[*language.FileInfo] = graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay)
+			if granularity.Level != language.FileLevel {
+				g := graph.NewGraph[*language.FileInfo]()
+				if err = g.Load(append(fromFiles, toFiles...), parser, callbacks); err != nil {
+					return err
+				}
+				collapsed, err := granularity.Collapse(g)
+				if err != nil {
+					return err
+				}
+				nodeParser, callbacks = graph.NewGraphParser(collapsed), nil
+				fromFiles, toFiles = groupIds(g, fromFiles, granularity), groupIds(g, toFiles, granularity)
+			}
+
 			deps, err := explain.Explain[*language.FileInfo](
-				parser,
+				nodeParser,
 				fromFiles,
 				toFiles,
-				graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay),
+				callbacks,
 			)
 			if err != nil {
 				return err
 			}
 
-			// If more than 1 package is referenced, display it.
-			shouldIncludePackagePrefix := moreThanOnePackage(deps)
+			// If more than 1 package is referenced, display it, unless files are collapsed.
+			shouldIncludePackagePrefix := granularity.Level == language.FileLevel && moreThanOnePackage(deps)
 
 			rendered := make([]string, len(deps))
-			for i, r := range deps {
+			for i, dep := range deps {
+				from, to := relPathDisplay(dep.From), relPathDisplay(dep.To)
 				if shouldIncludePackagePrefix {
-					fromPkg := r[0].Data.Package
-					if strings.HasPrefix(fromPkg, "@") {
-						fromPkg = fromPkg[1:]
-					}
-					toPkg := r[1].Data.Package
-					if strings.HasPrefix(toPkg, "@") {
-						toPkg = toPkg[1:]
-					}
-					rendered[i] = fromPkg + "@" + relPathDisplay(r[0]) + " -> " + toPkg + "@" + relPathDisplay(r[1])
-				} else {
-					rendered[i] = relPathDisplay(r[0]) + " -> " + relPathDisplay(r[1])
+					from = strings.TrimPrefix(dep.From.Data.Package, "@") + "@" + from
+					to = strings.TrimPrefix(dep.To.Data.Package, "@") + "@" + to
+				}
+				if line := dep.Data.Line(); line > 0 {
+					from += fmt.Sprintf(":%d", line)
+				}
+				rendered[i] = from + " -> " + to
+				if description := dep.Data.Describe(); description != "" {
+					rendered[i] += " (" + description + ")"
 				}
 			}
 
@@ -104,14 +124,15 @@ func ExplainCmd(cfgF func() (*config.Config, error)) *cobra.Command {
 
 	cmd.Flags().BoolVarP(&overlapLeft, "overlap-left", "l", false, "When there's an overlap between the files at the left and the right, keep the ones at the left")
 	cmd.Flags().BoolVarP(&overlapRight, "overlap-right", "r", false, "When there's an overlap between the files at the left and the right, keep the ones at the right")
+	addGranularityFlags(cmd, &granularity)
 
 	return cmd
 }
 
-func moreThanOnePackage(deps [][2]*graph.Node[*language.FileInfo]) bool {
+func moreThanOnePackage(deps []explain.Dependency[*language.FileInfo]) bool {
 	packages := map[string]struct{}{}
-	for _, nodes := range deps {
-		for _, node := range nodes {
+	for _, dep := range deps {
+		for _, node := range []*graph.Node[*language.FileInfo]{dep.From, dep.To} {
 			if _, ok := packages[node.Data.Package]; !ok {
 				packages[node.Data.Package] = struct{}{}
 				if len(packages) > 1 {
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func addGranularityFlags(cmd *cobra.Command, granularity *language.Granularity) {
+	cmd.Flags().StringVar(&granularity.Level, "granularity", language.FileLevel, "show the dependencies between files (file), between the directories where they are located (dir), or between their packages (package)")
+	cmd.Flags().IntVar(&granularity.Depth, "dir-depth", 0, "with --granularity=dir, collapse files into their directories up to this depth, starting from the root of the project. 0 means no limit")
+}
+
+// loadCollapsed loads the graph starting from the provided files, and collapses it with
+// the provided granularity, returning the ids that the files have in the collapsed graph.
+func loadCollapsed(
+	files []string,
+	parser graph.NodeParser[*language.FileInfo],
+	granularity language.Granularity,
+	callbacks graph.LoadCallbacks[*language.FileInfo],
+) (*graph.Graph[*language.FileInfo], []string, error) {
+	g := graph.NewGraph[*language.FileInfo]()
+	if err := g.Load(files, parser, callbacks); err != nil {
+		return nil, nil, err
+	}
+	return collapse(g, files, granularity)
+}
+
+// collapse collapses an already loaded graph with the provided granularity, returning the
+// ids that the files have in the collapsed graph. The graph is not modified.
+func collapse(
+	g *graph.Graph[*language.FileInfo],
+	files []string,
+	granularity language.Granularity,
+) (*graph.Graph[*language.FileInfo], []string, error) {
+	collapsed, err := granularity.Collapse(g)
+	if err != nil {
+		return nil, nil, err
+	}
+	return collapsed, groupIds(g, files, granularity), nil
+}
+
+// groupIds returns, without duplicates, the ids of the nodes where the files are collapsed
+// into. Files that are not in the graph are kept as they are.
+func groupIds(g *graph.Graph[*language.FileInfo], files []string, granularity language.Granularity) []string {
+	result := make([]string, 0, len(files))
+	seen := map[string]bool{}
+	for _, file := range files {
+		id := file
+		if node := g.Get(file); node != nil {
+			id = granularity.Group(node)
+		}
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
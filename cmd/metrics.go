@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gabotechs/dep-tree/internal/config"
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/metrics"
+)
+
+const (
+	tableOutput = "table"
+	jsonOutput  = "json"
+	csvOutput   = "csv"
+)
+
+func MetricsCmd(cfgF func() (*config.Config, error)) *cobra.Command {
+	var format string
+	var sortBy string
+	var top int
+	var granularity language.Granularity
+
+	cmd := &cobra.Command{
+		Use:     "metrics",
+		Short:   "Shows coupling and centrality metrics for each file, so that the hotspots of the code can be ranked",
+		GroupID: checkGroupId,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := granularity.Validate(); err != nil {
+				return err
+			}
+			if format != tableOutput && format != jsonOutput && format != csvOutput {
+				return fmt.Errorf("unknown format '%s', it must be one of %s, %s or %s", format, tableOutput, jsonOutput, csvOutput)
+			}
+
+			files, err := filesFromArgs(args)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := cfgF()
+			if err != nil {
+				return err
+			}
+
+			lang, files, err := inferLang(files, cfg)
+			if err != nil {
+				return err
+			}
+			defer closeLang(lang)
+
+			parser := language.NewParser(lang)
+			applyConfigToParser(parser, cfg)
+
+			g, ids, err := loadCollapsed(files, parser, granularity, graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay))
+			if err != nil {
+				return err
+			}
+
+			result := metrics.Compute(g, ids, relPathDisplay, abstractness)
+			if err = metrics.Rank(result, sortBy); err != nil {
+				return err
+			}
+			if top > 0 && top < len(result) {
+				result = result[:top]
+			}
+
+			rendered, err := renderMetrics(result, format)
+			if err != nil {
+				return err
+			}
+			cmd.Print(rendered)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", tableOutput, "output format, one of table, json or csv")
+	cmd.Flags().StringVar(&sortBy, "sort", "pagerank", "metric used for ranking the files, from the highest value to the lowest")
+	cmd.Flags().IntVar(&top, "top", 0, "only show this amount of files with the highest values. 0 means no limit")
+	addGranularityFlags(cmd, &granularity)
+
+	return cmd
+}
+
+func abstractness(node *graph.Node[*language.FileInfo]) *float64 {
+	return node.Data.Abstractness()
+}
+
+func renderMetrics(result []metrics.Metrics, format string) (string, error) {
+	var sb strings.Builder
+	switch format {
+	case jsonOutput:
+		rendered, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		sb.Write(rendered)
+		sb.WriteByte('\n')
+	case csvOutput:
+		w := csv.NewWriter(&sb)
+		for _, row := range metricsRows(result) {
+			if err := w.Write(row); err != nil {
+				return "", err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return "", err
+		}
+	default:
+		w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+		for _, row := range metricsRows(result) {
+			_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		if err := w.Flush(); err != nil {
+			return "", err
+		}
+	}
+	return sb.String(), nil
+}
+
+// metricsRows returns the header followed by one row for each one of the metrics.
+func metricsRows(result []metrics.Metrics) [][]string {
+	rows := make([][]string, 0, len(result)+1)
+	header := make([]string, len(metrics.Columns))
+	for i, column := range metrics.Columns {
+		header[i] = column.Name
+	}
+	rows = append(rows, header)
+	for _, m := range result {
+		row := make([]string, len(metrics.Columns))
+		for i, column := range metrics.Columns {
+			row[i] = column.Format(m)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
@@ -1,20 +1,39 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 
 	"github.com/bmatcuk/doublestar/v4"
+	"github.com/gabotechs/dep-tree/internal/cache"
+	"github.com/gabotechs/dep-tree/internal/check"
 	"github.com/gabotechs/dep-tree/internal/config"
+	"github.com/gabotechs/dep-tree/internal/cpp"
+	"github.com/gabotechs/dep-tree/internal/csharp"
+	"github.com/gabotechs/dep-tree/internal/css"
+	"github.com/gabotechs/dep-tree/internal/dart"
 	"github.com/gabotechs/dep-tree/internal/dummy"
+	"github.com/gabotechs/dep-tree/internal/elixir"
 	golang "github.com/gabotechs/dep-tree/internal/go"
 	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/java"
 	"github.com/gabotechs/dep-tree/internal/js"
+	"github.com/gabotechs/dep-tree/internal/kotlin"
 	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/mixed"
+	"github.com/gabotechs/dep-tree/internal/php"
+	"github.com/gabotechs/dep-tree/internal/plugin"
+	"github.com/gabotechs/dep-tree/internal/protobuf"
 	"github.com/gabotechs/dep-tree/internal/python"
+	"github.com/gabotechs/dep-tree/internal/ruby"
 	"github.com/gabotechs/dep-tree/internal/rust"
+	"github.com/gabotechs/dep-tree/internal/terraform"
+	"github.com/gabotechs/dep-tree/internal/unused"
 	"github.com/gabotechs/dep-tree/internal/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -25,6 +44,8 @@ const renderGroupId = "render"
 const checkGroupId = "check"
 const defaultCommand = "entropy"
 
+const version = "v0.23.4"
+
 func NewRoot(args []string) *cobra.Command {
 	if args == nil {
 		args = os.Args[1:]
@@ -32,7 +53,7 @@ func NewRoot(args []string) *cobra.Command {
 
 	root := &cobra.Command{
 		Use:               "dep-tree",
-		Version:           "v0.23.4",
+		Version:           version,
 		Short:             "Visualize and check your project's dependency graph",
 		SilenceUsage:      true,
 		Args:              cobra.ArbitraryArgs,
@@ -61,6 +82,7 @@ $ dep-tree check`,
 	cliCfg := config.NewConfigCwd()
 
 	var fileConfigPath string
+	var jobs int
 
 	root.Flags().SortFlags = false
 	root.PersistentFlags().SortFlags = false
@@ -69,6 +91,9 @@ $ dep-tree check`,
 	root.PersistentFlags().BoolVar(&cliCfg.Js.TsConfigPaths, "js-tsconfig-paths", true, "follow the tsconfig.json paths while resolving imports.")
 	root.PersistentFlags().BoolVar(&cliCfg.Js.Workspaces, "js-workspaces", true, "take the workspaces attribute in the root package.json into account for resolving paths.")
 	root.PersistentFlags().BoolVar(&cliCfg.Python.ExcludeConditionalImports, "python-exclude-conditional-imports", false, "exclude imports wrapped inside if or try statements. (default false)")
+	root.PersistentFlags().BoolVar(&cliCfg.Cache.Enabled, "cache", false, "persist parsed files in a cache, so that only files that changed are parsed in subsequent runs. (default false)")
+	root.PersistentFlags().BoolVar(&cliCfg.Mixed.Enabled, "mixed", false, "analyze files from all the supported languages in the same graph, instead of inferring a single one. (default false)")
+	root.PersistentFlags().IntVarP(&jobs, "jobs", "j", runtime.NumCPU(), "amount of files that are parsed in parallel.")
 	root.PersistentFlags().StringArrayVar(&cliCfg.Only, "only", nil, "Files that do not match this glob pattern will be ignored. You can provide an arbitrary number of --only flags.")
 	root.PersistentFlags().StringArrayVar(&cliCfg.Exclude, "exclude", nil, "Files that match this glob pattern will be ignored. You can provide an arbitrary number of --exclude flags.")
 
@@ -95,6 +120,12 @@ $ dep-tree check`,
 				*a.dest = *a.source
 			}
 		}
+		if root.PersistentFlags().Changed("cache") {
+			fileCfg.Cache.Enabled = cliCfg.Cache.Enabled
+		}
+		if root.PersistentFlags().Changed("mixed") {
+			fileCfg.Mixed.Enabled = cliCfg.Mixed.Enabled
+		}
 		// NOTE: hard-enable this for now, as they don't produce a very good output.
 		fileCfg.Python.IgnoreFromImportsAsExports = true
 		fileCfg.Python.IgnoreDirectoryImports = true
@@ -103,6 +134,8 @@ $ dep-tree check`,
 		fileCfg.Exclude = append(fileCfg.Exclude, cliCfg.Exclude...)
 		fileCfg.Only = append(fileCfg.Only, cliCfg.Only...)
 
+		fileCfg.Jobs = jobs
+
 		return fileCfg, fileCfg.ValidatePatterns()
 	}
 
@@ -110,8 +143,12 @@ $ dep-tree check`,
 		EntropyCmd(cfgF),
 		TreeCmd(cfgF),
 		CheckCmd(cfgF),
+		CyclesCmd(cfgF),
 		ConfigCmd(cfgF),
 		ExplainCmd(cfgF),
+		MetricsCmd(cfgF),
+		UnusedCmd(cfgF),
+		DiffCmd(cfgF),
 	)
 
 	switch {
@@ -131,73 +168,195 @@ $ dep-tree check`,
 	return root
 }
 
-//nolint:gocyclo
-func inferLang(files []string, cfg *config.Config) (language.Language, error) {
+// backends are all the supported languages, in the order in which they are
+// matched against file extensions.
+func backends(cfg *config.Config) []mixed.Backend {
+	// plugins go first, so that they take precedence over the built-in languages.
+	var result []mixed.Backend
+	for i := range cfg.Plugins {
+		p := &cfg.Plugins[i]
+		result = append(result, mixed.Backend{Name: p.Command, Extensions: p.Extensions, Make: func(string) (language.Language, error) {
+			lang, err := plugin.MakePluginLanguage(p)
+			if err != nil {
+				return nil, err
+			}
+			return lang, nil
+		}})
+	}
+	return append(result, []mixed.Backend{
+		{Name: "js", Extensions: js.Extensions, Make: func(string) (language.Language, error) {
+			return js.MakeJsLanguage(&cfg.Js)
+		}},
+		{Name: "rust", Extensions: rust.Extensions, Make: func(string) (language.Language, error) {
+			return rust.MakeRustLanguage(&cfg.Rust)
+		}},
+		{Name: "python", Extensions: python.Extensions, Make: func(string) (language.Language, error) {
+			return python.MakePythonLanguage(&cfg.Python)
+		}},
+		{Name: "golang", Extensions: golang.Extensions, Make: func(file string) (language.Language, error) {
+			return golang.NewLanguage(file, &cfg.Golang)
+		}},
+		{Name: "java", Extensions: java.Extensions, Make: func(string) (language.Language, error) {
+			return java.MakeJavaLanguage(&cfg.Java)
+		}},
+		{Name: "cpp", Extensions: cpp.Extensions, Make: func(string) (language.Language, error) {
+			return cpp.MakeCppLanguage(&cfg.Cpp)
+		}},
+		{Name: "csharp", Extensions: csharp.Extensions, Make: func(string) (language.Language, error) {
+			return csharp.MakeCsharpLanguage(&cfg.Csharp)
+		}},
+		{Name: "kotlin", Extensions: kotlin.Extensions, Make: func(string) (language.Language, error) {
+			return kotlin.MakeKotlinLanguage(&cfg.Kotlin)
+		}},
+		{Name: "ruby", Extensions: ruby.Extensions, Make: func(string) (language.Language, error) {
+			return ruby.MakeRubyLanguage(&cfg.Ruby)
+		}},
+		{Name: "php", Extensions: php.Extensions, Make: func(string) (language.Language, error) {
+			return php.MakePhpLanguage(&cfg.Php)
+		}},
+		{Name: "css", Extensions: css.Extensions, Make: func(string) (language.Language, error) {
+			return css.MakeCssLanguage(&cfg.Css)
+		}},
+		{Name: "protobuf", Extensions: protobuf.Extensions, Make: func(string) (language.Language, error) {
+			return protobuf.MakeProtobufLanguage(&cfg.Protobuf)
+		}},
+		{Name: "terraform", Extensions: terraform.Extensions, Make: func(string) (language.Language, error) {
+			return terraform.MakeTerraformLanguage(&cfg.Terraform)
+		}},
+		{Name: "dart", Extensions: dart.Extensions, Make: func(string) (language.Language, error) {
+			return dart.MakeDartLanguage(&cfg.Dart)
+		}},
+		{Name: "elixir", Extensions: elixir.Extensions, Make: func(string) (language.Language, error) {
+			return elixir.MakeElixirLanguage(&cfg.Elixir)
+		}},
+		{Name: "dummy", Extensions: dummy.Extensions, Make: func(string) (language.Language, error) {
+			return &dummy.Language{}, nil
+		}},
+	}...)
+}
+
+// closeLang shuts down what the language returned by inferLang spawned, like plugin
+// processes. It is meant to be deferred right after inferLang, so that plugins exit
+// along with the command.
+func closeLang(lang language.Language) {
+	if closer, ok := lang.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}
+}
+
+// inferLang returns the language for analyzing the provided files, along with the files
+// that it is able to analyze. With mixed-language graphs, files that no language supports
+// are skipped, the same as they do not take part in inferring a single language.
+func inferLang(files []string, cfg *config.Config) (language.Language, []string, error) {
 	if len(files) == 0 {
-		return nil, fmt.Errorf("at least 1 file must be provided for inferring the language")
-	}
-	score := struct {
-		js     int
-		python int
-		rust   int
-		golang int
-		dummy  int
-	}{}
+		return nil, nil, fmt.Errorf("at least 1 file must be provided for inferring the language")
+	}
+	candidates := backends(cfg)
+	if cfg.Cache.Enabled {
+		candidates = withCache(candidates, cfg)
+	}
+	if cfg.Mixed.Enabled {
+		supported := sameLang(files, files, cfg)
+		if len(supported) == 0 {
+			return nil, nil, errors.New("none of the provided files belong to the a supported language")
+		}
+		lang, err := mixed.MakeMixedLanguage(&cfg.Mixed, candidates)
+		return lang, supported, err
+	}
+	i, first := inferBackend(files, candidates)
+	if i == -1 {
+		return nil, nil, errors.New("none of the provided files belong to the a supported language")
+	}
+	lang, err := candidates[i].Make(first)
+	return lang, files, err
+}
+
+// inferBackend returns the index of the backend that handles most of the provided files,
+// along with the first file that it handles, or -1 if none of them is handled.
+func inferBackend(files []string, candidates []mixed.Backend) (int, string) {
+	score := make([]int, len(candidates))
+	first := make([]string, len(candidates))
 	top := struct {
-		lang string
-		v    int
-	}{}
+		i int
+		v int
+	}{i: -1}
 	for _, file := range files {
-		switch {
-		case utils.EndsWith(file, js.Extensions):
-			score.js += 1
-			if score.js > top.v {
-				top.v = score.js
-				top.lang = "js"
+		for i, candidate := range candidates {
+			if !utils.EndsWith(file, candidate.Extensions) {
+				continue
 			}
-		case utils.EndsWith(file, rust.Extensions):
-			score.rust += 1
-			if score.rust > top.v {
-				top.v = score.rust
-				top.lang = "rust"
+			score[i] += 1
+			if first[i] == "" {
+				first[i] = file
 			}
-		case utils.EndsWith(file, python.Extensions):
-			score.python += 1
-			if score.python > top.v {
-				top.v = score.python
-				top.lang = "python"
+			if score[i] > top.v {
+				top.v = score[i]
+				top.i = i
 			}
-		case utils.EndsWith(file, golang.Extensions):
-			score.golang += 1
-			if score.golang > top.v {
-				top.v = score.golang
-				top.lang = "golang"
-			}
-		case utils.EndsWith(file, dummy.Extensions):
-			score.dummy += 1
-			if score.dummy > top.v {
-				top.v = score.dummy
-				top.lang = "dummy"
+			break
+		}
+	}
+	if top.i == -1 {
+		return -1, ""
+	}
+	return top.i, first[top.i]
+}
+
+// sameLang keeps only the files that would be analyzed by the language inferred from the
+// entrypoints, which are all the supported ones when mixed-language graphs are enabled.
+func sameLang(entrypoints []string, files []string, cfg *config.Config) []string {
+	candidates := backends(cfg)
+	if !cfg.Mixed.Enabled {
+		i, _ := inferBackend(entrypoints, candidates)
+		if i == -1 {
+			return nil
+		}
+		candidates = candidates[i : i+1]
+	}
+	var result []string
+	for _, file := range files {
+		for _, candidate := range candidates {
+			if utils.EndsWith(file, candidate.Extensions) {
+				result = append(result, file)
+				break
 			}
 		}
 	}
-	if top.lang == "" {
-		return nil, errors.New("none of the provided files belong to the a supported language")
-	}
-	switch top.lang {
-	case "js":
-		return js.MakeJsLanguage(&cfg.Js)
-	case "rust":
-		return rust.MakeRustLanguage(&cfg.Rust)
-	case "python":
-		return python.MakePythonLanguage(&cfg.Python)
-	case "golang":
-		return golang.NewLanguage(files[0], &cfg.Golang)
-	case "dummy":
-		return &dummy.Language{}, nil
-	default:
-		return nil, fmt.Errorf("file \"%s\" not supported", files[0])
+	return result
+}
+
+// withCache makes the backends persist the parsed files in the cache. Entries are
+// keyed by the dep-tree version and by the settings that affect how files are parsed.
+func withCache(candidates []mixed.Backend, cfg *config.Config) []mixed.Backend {
+	settings := *cfg
+	settings.Check = check.Config{}
+	settings.Unused = unused.Config{}
+	settings.Exclude = nil
+	settings.Only = nil
+	settings.Jobs = 0
+	settings.Cache = cache.Config{}
+	key, _ := json.Marshal(settings)
+
+	result := make([]mixed.Backend, len(candidates))
+	for i, candidate := range candidates {
+		candidate := candidate
+		result[i] = candidate
+		result[i].Make = func(file string) (language.Language, error) {
+			lang, err := candidate.Make(file)
+			if err != nil {
+				return nil, err
+			}
+			cached, err := cache.MakeCachedLanguage(lang, &cfg.Cache, version+"\x00"+candidate.Name+"\x00"+string(key))
+			if err != nil {
+				closeLang(lang)
+				return nil, err
+			}
+			return cached, nil
+		}
 	}
+	return result
 }
 
 func filesFromArgs(args []string) ([]string, error) {
@@ -236,6 +395,7 @@ func applyConfigToParser(parser *language.Parser, cfg *config.Config) {
 	parser.UnwrapProxyExports = cfg.UnwrapExports
 	parser.Exclude = cfg.Exclude
 	parser.Include = cfg.Only
+	parser.Jobs = cfg.Jobs
 }
 
 func relPathDisplay(node *graph.Node[*language.FileInfo]) string {
@@ -2,14 +2,21 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"os"
+	"os/exec"
 	"path/filepath"
 	"slices"
 	"strings"
 	"testing"
 
 	"github.com/gabotechs/dep-tree/internal/config"
+	golang "github.com/gabotechs/dep-tree/internal/go"
+	"github.com/gabotechs/dep-tree/internal/graph"
 	"github.com/gabotechs/dep-tree/internal/js"
 	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/mixed"
+	"github.com/gabotechs/dep-tree/internal/plugin"
 	"github.com/gabotechs/dep-tree/internal/python"
 	"github.com/gabotechs/dep-tree/internal/rust"
 	"github.com/stretchr/testify/require"
@@ -90,6 +97,48 @@ func TestRoot(t *testing.T) {
 		{
 			Name: "tree .root_test/main.py --json --config .root_test/.dep-tree.yml-bad-path",
 		},
+		{
+			Name: "tree .root_test/main.py --json --granularity dir",
+		},
+		{
+			Name: "tree .root_test/main.py --json --granularity module",
+		},
+		{
+			Name: "cycles .root_test/main.py",
+		},
+		{
+			Name: "cycles .root_test/main.py --suggest-cuts",
+		},
+		{
+			Name: "cycles .root_test/cycles/a.js",
+		},
+		{
+			Name: "cycles .root_test/cycles/a.js --suggest-cuts",
+		},
+		{
+			Name: "metrics .root_test/main.py",
+		},
+		{
+			Name: "metrics .root_test/main.py --format csv --sort afferent --top 2",
+		},
+		{
+			Name: "metrics .root_test/main.py --format json --granularity dir",
+		},
+		{
+			Name: "metrics .root_test/main.py --sort loc",
+		},
+		{
+			Name: "unused .root_test/main.py --files .root_test/**/*.py",
+		},
+		{
+			Name: "unused .root_test/main.py --files .root_test/**/*.py --allow .root_test/dep/*.py",
+		},
+		{
+			Name: "unused --config .root_test/.dep-tree.yml --files .root_test/*.py",
+		},
+		{
+			Name: "unused .root_test/main.py",
+		},
 		{
 			Name: "explain .root_test/*.py",
 		},
@@ -138,10 +187,12 @@ func TestRoot(t *testing.T) {
 
 func TestInferLang(t *testing.T) {
 	tests := []struct {
-		Name     string
-		Files    []string
-		Expected language.Language
-		Error    string
+		Name          string
+		Files         []string
+		Mixed         bool
+		Expected      language.Language
+		ExpectedFiles []string
+		Error         string
 	}{
 		{
 			Name:  "zero files",
@@ -168,22 +219,77 @@ func TestInferLang(t *testing.T) {
 			Files: []string{"foo.pdf", "bar.docx"},
 			Error: "none of the provided files belong to the a supported language",
 		},
+		{
+			Name:     "mixed languages",
+			Files:    []string{"foo.js", "bar.rs", "foo.rs", "foo.py"},
+			Mixed:    true,
+			Expected: &mixed.Language{},
+		},
+		{
+			Name:          "mixed languages with unsupported files",
+			Files:         []string{"foo.js", "notes.txt", "foo.py"},
+			Mixed:         true,
+			Expected:      &mixed.Language{},
+			ExpectedFiles: []string{"foo.js", "foo.py"},
+		},
+		{
+			Name:  "mixed languages with no supported files",
+			Files: []string{"notes.txt"},
+			Mixed: true,
+			Error: "none of the provided files belong to the a supported language",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.Name, func(t *testing.T) {
 			a := require.New(t)
-			lang, err := inferLang(tt.Files, &config.Config{})
+			lang, files, err := inferLang(tt.Files, &config.Config{Mixed: mixed.Config{Enabled: tt.Mixed}})
 			if tt.Error != "" {
 				a.ErrorContains(err, tt.Error)
 			} else {
 				a.NoError(err)
 				a.IsType(tt.Expected, lang)
+				if tt.ExpectedFiles == nil {
+					tt.ExpectedFiles = tt.Files
+				}
+				a.Equal(tt.ExpectedFiles, files)
 			}
 		})
 	}
 }
 
+func TestCloseLang(t *testing.T) {
+	bin := filepath.Join(t.TempDir(), "dummy-plugin")
+	out, err := exec.Command("go", "build", "-o", bin, "../internal/plugin/dummy-plugin").CombinedOutput()
+	require.NoError(t, err, string(out))
+	absTestFolder, _ := filepath.Abs(filepath.Join("..", "internal", "plugin", ".plugin_test"))
+
+	tests := []struct {
+		Name  string
+		Mixed bool
+	}{
+		{Name: "single language"},
+		{Name: "mixed languages", Mixed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, _, err := inferLang([]string{"main.dl"}, &config.Config{
+				Plugins: []plugin.Config{{Command: bin, Extensions: []string{"dl"}}},
+				Mixed:   mixed.Config{Enabled: tt.Mixed},
+			})
+			a.NoError(err)
+			_, err = lang.ParseFile(filepath.Join(absTestFolder, "main.dl"))
+			a.NoError(err)
+
+			closeLang(lang)
+			_, err = lang.ParseFile(filepath.Join(absTestFolder, "main.dl"))
+			a.ErrorContains(err, "is not accepting requests")
+		})
+	}
+}
+
 func TestFilesFromArgs(t *testing.T) {
 	absPath, _ := filepath.Abs("..")
 
@@ -240,21 +346,38 @@ func TestFilesFromArgs(t *testing.T) {
 			Expected: []string{
 				filepath.Join("cmd", "check.go"),
 				filepath.Join("cmd", "config.go"),
+				filepath.Join("cmd", "cycles.go"),
+				filepath.Join("cmd", "diff.go"),
 				filepath.Join("cmd", "entropy.go"),
 				filepath.Join("cmd", "explain.go"),
+				filepath.Join("cmd", "granularity.go"),
+				filepath.Join("cmd", "metrics.go"),
 				filepath.Join("cmd", "root.go"),
 				filepath.Join("cmd", "root_test.go"),
 				filepath.Join("cmd", "tree.go"),
+				filepath.Join("cmd", "unused.go"),
+				filepath.Join("cmd", "watch.go"),
 			},
 		},
 		{
 			Name:  "Double globstar expansion (1)",
 			Input: []string{"../internal/**/*mports_test.go"},
 			Expected: []string{
+				filepath.Join("internal", "cpp", "imports_test.go"),
+				filepath.Join("internal", "csharp", "imports_test.go"),
+				filepath.Join("internal", "css", "imports_test.go"),
+				filepath.Join("internal", "dart", "imports_test.go"),
+				filepath.Join("internal", "elixir", "imports_test.go"),
 				filepath.Join("internal", "go", "imports_test.go"),
+				filepath.Join("internal", "java", "imports_test.go"),
 				filepath.Join("internal", "js", "imports_test.go"),
+				filepath.Join("internal", "kotlin", "imports_test.go"),
+				filepath.Join("internal", "php", "imports_test.go"),
+				filepath.Join("internal", "protobuf", "imports_test.go"),
 				filepath.Join("internal", "python", "imports_test.go"),
+				filepath.Join("internal", "ruby", "imports_test.go"),
 				filepath.Join("internal", "rust", "imports_test.go"),
+				filepath.Join("internal", "terraform", "imports_test.go"),
 				filepath.Join("internal", "language", "imports_test.go"),
 			},
 		},
@@ -262,21 +385,54 @@ func TestFilesFromArgs(t *testing.T) {
 			Name:  "Double globstar expansion (2)",
 			Input: []string{"../internal/**/grammar_test.go"},
 			Expected: []string{
+				filepath.Join("internal", "cpp", "cpp_grammar", "grammar_test.go"),
+				filepath.Join("internal", "csharp", "csharp_grammar", "grammar_test.go"),
+				filepath.Join("internal", "css", "css_grammar", "grammar_test.go"),
+				filepath.Join("internal", "dart", "dart_grammar", "grammar_test.go"),
+				filepath.Join("internal", "elixir", "elixir_grammar", "grammar_test.go"),
+				filepath.Join("internal", "java", "java_grammar", "grammar_test.go"),
 				filepath.Join("internal", "js", "js_grammar", "grammar_test.go"),
+				filepath.Join("internal", "kotlin", "kotlin_grammar", "grammar_test.go"),
+				filepath.Join("internal", "php", "php_grammar", "grammar_test.go"),
+				filepath.Join("internal", "protobuf", "protobuf_grammar", "grammar_test.go"),
+				filepath.Join("internal", "ruby", "ruby_grammar", "grammar_test.go"),
+				filepath.Join("internal", "terraform", "terraform_grammar", "grammar_test.go"),
 			},
 		},
 		{
 			Name:  "Double globstar expansion (3)",
 			Input: []string{"../../dep-tree/inte*/**/grammar_test.go"},
 			Expected: []string{
+				filepath.Join("internal", "cpp", "cpp_grammar", "grammar_test.go"),
+				filepath.Join("internal", "csharp", "csharp_grammar", "grammar_test.go"),
+				filepath.Join("internal", "css", "css_grammar", "grammar_test.go"),
+				filepath.Join("internal", "dart", "dart_grammar", "grammar_test.go"),
+				filepath.Join("internal", "elixir", "elixir_grammar", "grammar_test.go"),
+				filepath.Join("internal", "java", "java_grammar", "grammar_test.go"),
 				filepath.Join("internal", "js", "js_grammar", "grammar_test.go"),
+				filepath.Join("internal", "kotlin", "kotlin_grammar", "grammar_test.go"),
+				filepath.Join("internal", "php", "php_grammar", "grammar_test.go"),
+				filepath.Join("internal", "protobuf", "protobuf_grammar", "grammar_test.go"),
+				filepath.Join("internal", "ruby", "ruby_grammar", "grammar_test.go"),
+				filepath.Join("internal", "terraform", "terraform_grammar", "grammar_test.go"),
 			},
 		},
 		{
 			Name:  "Double globstar expansion (4)",
 			Input: []string{filepath.Join(absPath, "../dep-tree/internal/**/grammar_test.go")},
 			Expected: []string{
+				filepath.Join("internal", "cpp", "cpp_grammar", "grammar_test.go"),
+				filepath.Join("internal", "csharp", "csharp_grammar", "grammar_test.go"),
+				filepath.Join("internal", "css", "css_grammar", "grammar_test.go"),
+				filepath.Join("internal", "dart", "dart_grammar", "grammar_test.go"),
+				filepath.Join("internal", "elixir", "elixir_grammar", "grammar_test.go"),
+				filepath.Join("internal", "java", "java_grammar", "grammar_test.go"),
 				filepath.Join("internal", "js", "js_grammar", "grammar_test.go"),
+				filepath.Join("internal", "kotlin", "kotlin_grammar", "grammar_test.go"),
+				filepath.Join("internal", "php", "php_grammar", "grammar_test.go"),
+				filepath.Join("internal", "protobuf", "protobuf_grammar", "grammar_test.go"),
+				filepath.Join("internal", "ruby", "ruby_grammar", "grammar_test.go"),
+				filepath.Join("internal", "terraform", "terraform_grammar", "grammar_test.go"),
 			},
 		},
 	}
@@ -301,3 +457,48 @@ func TestFilesFromArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestWatchGraph(t *testing.T) {
+	a := require.New(t)
+	dir := t.TempDir()
+	write := func(path string, content string) {
+		path = filepath.Join(dir, path)
+		a.NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+		a.NoError(os.WriteFile(path, []byte(content), 0o600))
+	}
+	write("go.mod", "module example\n\ngo 1.21\n")
+	write("a/a.go", "package a\n\nfunc A() {}\n")
+	write("b/b.go", "package b\n\nfunc B() {}\n")
+	write("main.go", "package main\n\nimport \"example/a\"\n\nfunc main() {\n\ta.A()\n}\n")
+	mainPath := filepath.Join(dir, "main.go")
+
+	lang, err := golang.NewLanguage(dir, &golang.Config{})
+	a.NoError(err)
+	cfg := &config.Config{Path: dir, File: filepath.Join(dir, ".dep-tree.yml")}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	renders := make(chan []string)
+	done := make(chan error)
+	go func() {
+		done <- watchGraph(ctx, []string{mainPath}, language.NewParser(lang), cfg, func(g *graph.Graph[*language.FileInfo]) error {
+			var deps []string
+			for _, node := range g.FromId(mainPath) {
+				deps = append(deps, node.Data.RelPath)
+			}
+			slices.Sort(deps)
+			renders <- deps
+			return nil
+		})
+	}()
+
+	a.Equal([]string{"a/a.go"}, <-renders)
+	write("main.go", "package main\n\nimport (\n\t\"example/a\"\n\t\"example/b\"\n)\n\nfunc main() {\n\ta.A()\n\tb.B()\n}\n")
+	a.Equal([]string{"a/a.go", "b/b.go"}, <-renders)
+	write("a/a.go", "package a\n\nfunc B() {}\n")
+	write("a/c.go", "package a\n\nfunc A() {}\n")
+	a.Equal([]string{"a/c.go", "b/b.go"}, <-renders)
+
+	cancel()
+	a.NoError(<-done)
+}
@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/gabotechs/dep-tree/internal/config"
 	"github.com/gabotechs/dep-tree/internal/graph"
 	"github.com/gabotechs/dep-tree/internal/language"
@@ -12,6 +15,8 @@ import (
 
 func TreeCmd(cfgF func() (*config.Config, error)) *cobra.Command {
 	var jsonFormat bool
+	var watchFiles bool
+	var granularity language.Granularity
 
 	cmd := &cobra.Command{
 		Use:     "tree",
@@ -19,52 +24,97 @@ func TreeCmd(cfgF func() (*config.Config, error)) *cobra.Command {
 		Args:    cobra.MinimumNArgs(1),
 		GroupID: renderGroupId,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			files, err := filesFromArgs(args)
-			if err != nil {
-				return err
-			}
-
-			cfg, err := cfgF()
-			if err != nil {
-				return err
+			if watchFiles && !jsonFormat {
+				return errors.New("--watch is only supported along with --json")
 			}
-
-			lang, err := inferLang(files, cfg)
-			if err != nil {
+			if err := granularity.Validate(); err != nil {
 				return err
 			}
+			run := func() error {
+				files, err := filesFromArgs(args)
+				if err != nil {
+					return err
+				}
 
-			parser := language.NewParser(lang)
-			applyConfigToParser(parser, cfg)
+				cfg, err := cfgF()
+				if err != nil {
+					return err
+				}
 
-			if jsonFormat {
-				t, err := tree.NewTree[*language.FileInfo](
-					files,
-					parser,
-					relPathDisplay,
-					graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay),
-				)
+				lang, files, err := inferLang(files, cfg)
 				if err != nil {
 					return err
 				}
+				defer closeLang(lang)
 
-				rendered, err := t.RenderStructured()
-				cmd.Println(rendered)
-				return err
-			} else {
-				return tui.Loop[*language.FileInfo](
-					files,
-					parser,
-					relPathDisplay,
-					nil,
-					true,
-					nil,
-					graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay))
+				parser := language.NewParser(lang)
+				applyConfigToParser(parser, cfg)
+
+				if watchFiles {
+					if len(files) != 1 {
+						return fmt.Errorf("this functionality requires that only 1 entrypoint is provided, but %d where passed", len(files))
+					}
+					return watchGraph(cmd.Context(), files, parser, cfg, func(g *graph.Graph[*language.FileInfo]) error {
+						collapsed, ids, err := collapse(g, files, granularity)
+						if err != nil {
+							return err
+						}
+						t, err := tree.NewTreeFromGraph[*language.FileInfo](collapsed, ids[0], parser, relPathDisplay)
+						if err != nil {
+							return err
+						}
+						rendered, err := t.RenderStructured()
+						cmd.Println(rendered)
+						return err
+					})
+				}
+
+				// with a coarser granularity, the tree is built from the collapsed graph.
+				var nodeParser graph.NodeParser[*language.FileInfo] = parser
+				var callbacks graph.LoadCallbacks[*language.FileInfo] = graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay)
+				if granularity.Level != language.FileLevel {
+					collapsed, ids, err := loadCollapsed(files, parser, granularity, callbacks)
+					if err != nil {
+						return err
+					}
+					nodeParser, files, callbacks = graph.NewGraphParser(collapsed), ids, nil
+				}
+
+				if jsonFormat {
+					t, err := tree.NewTree[*language.FileInfo](
+						files,
+						nodeParser,
+						relPathDisplay,
+						callbacks,
+					)
+					if err != nil {
+						return err
+					}
+
+					rendered, err := t.RenderStructured()
+					cmd.Println(rendered)
+					return err
+				} else {
+					return tui.Loop[*language.FileInfo](
+						files,
+						nodeParser,
+						relPathDisplay,
+						nil,
+						true,
+						nil,
+						callbacks)
+				}
+			}
+			if watchFiles {
+				return restartOnConfigChange(run)
 			}
+			return run()
 		},
 	}
 
 	cmd.Flags().BoolVar(&jsonFormat, "json", false, "render the dependency tree in a machine readable json format")
+	cmd.Flags().BoolVar(&watchFiles, "watch", false, "render the dependency tree again each time a file changes, only supported along with --json")
+	addGranularityFlags(cmd, &granularity)
 
 	return cmd
 }
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gabotechs/dep-tree/internal/config"
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/unused"
+)
+
+func UnusedCmd(cfgF func() (*config.Config, error)) *cobra.Command {
+	var cliCfg unused.Config
+
+	cmd := &cobra.Command{
+		Use:     "unused",
+		Short:   "Shows the files that are not reachable from the entrypoints, and the exported symbols that no file imports",
+		GroupID: checkGroupId,
+		Args:    cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := cfgF()
+			if err != nil {
+				return err
+			}
+
+			entrypoints := cfg.Check.EntrypointPaths()
+			if len(args) > 0 {
+				if entrypoints, err = filesFromArgs(args); err != nil {
+					return err
+				}
+			}
+			if len(entrypoints) == 0 {
+				return errors.New("some entrypoints must be provided, either as arguments or in the check.entrypoints section of the config file")
+			}
+
+			cwd, _ := os.Getwd()
+			cliCfg.EnsureAbsPaths(cwd)
+			cfg.Unused.Files = append(cfg.Unused.Files, cliCfg.Files...)
+			cfg.Unused.Allow = append(cfg.Unused.Allow, cliCfg.Allow...)
+			if err = cfg.ValidatePatterns(); err != nil {
+				return err
+			}
+			if len(cfg.Unused.Files) == 0 {
+				return errors.New("the source files must be provided with --files, or in the unused.files section of the config file")
+			}
+			files, err := filesFromArgs(cfg.Unused.Files)
+			if err != nil {
+				return err
+			}
+
+			lang, entrypoints, err := inferLang(entrypoints, cfg)
+			if err != nil {
+				return err
+			}
+			defer closeLang(lang)
+			parser := language.NewParser(lang)
+			applyConfigToParser(parser, cfg)
+
+			result, err := unused.Find(
+				parser,
+				entrypoints,
+				sameLang(entrypoints, files, cfg),
+				&cfg.Unused,
+				relPathDisplay,
+				graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay),
+			)
+			if err != nil {
+				return err
+			}
+			if result.Empty() {
+				cmd.Println("No unused files or exports found")
+				return nil
+			}
+			return errors.New(result.Render())
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&cliCfg.Files, "files", nil, "glob pattern matching all the source files, the ones that are not reachable from the entrypoints are reported. You can provide an arbitrary number of --files flags")
+	cmd.Flags().StringArrayVar(&cliCfg.Allow, "allow", nil, "glob pattern matching files that are never reported, like the ones loaded dynamically. You can provide an arbitrary number of --allow flags")
+
+	return cmd
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gabotechs/dep-tree/internal/config"
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/watch"
+)
+
+const watchInterval = 300 * time.Millisecond
+
+var errConfigChanged = errors.New("config file changed")
+
+// restartOnConfigChange calls run again each time it returns errConfigChanged, so
+// that everything is built again with the new config.
+func restartOnConfigChange(run func() error) error {
+	for {
+		err := run()
+		if !errors.Is(err, errConfigChanged) {
+			return err
+		}
+		_, _ = fmt.Fprintln(os.Stderr, "Config file changed, reloading...")
+	}
+}
+
+// watchGraph loads the graph and calls render with it each time the files from which
+// it was loaded change. Only the changed files are parsed again. Errors returned by
+// render are printed, but they do not stop watching. If the config file changes,
+// errConfigChanged is returned.
+func watchGraph(
+	ctx context.Context,
+	files []string,
+	parser *language.Parser,
+	cfg *config.Config,
+	render func(g *graph.Graph[*language.FileInfo]) error,
+) error {
+	g := graph.NewGraph[*language.FileInfo]()
+	err := g.Load(files, parser, graph.NewStdErrCallbacks[*language.FileInfo](relPathDisplay))
+	if err != nil {
+		return err
+	}
+
+	watcher := watch.NewWatcher(watchInterval)
+	// files that were removed are still watched, in case they are created again.
+	removed := make(map[string]bool)
+	for {
+		if err = render(g); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+		}
+
+		watched := append([]string{cfg.File}, files...)
+		inGraph := make(map[string]bool)
+		for _, node := range g.AllNodes() {
+			watched = append(watched, node.Id)
+			inGraph[node.Id] = true
+		}
+		for path := range removed {
+			watched = append(watched, path)
+		}
+		watcher.Unwatch(watched...)
+		watcher.Watch(watched...)
+
+		changed, err := watcher.Wait(ctx)
+		if errors.Is(err, context.Canceled) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		reload := false
+		for _, path := range changed {
+			if path == cfg.File {
+				return errConfigChanged
+			}
+			if !inGraph[path] {
+				reload = true
+			}
+			delete(removed, path)
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "\n%s changed, updating...\n", strings.Join(relPaths(cfg.Path, changed), ", "))
+		parser.Invalidate(changed...)
+		if reload {
+			// the changed files are not part of the graph, so there is no way of
+			// knowing which nodes depend on them, it's loaded again from scratch.
+			g = graph.NewGraph[*language.FileInfo]()
+			err = g.Load(files, parser, nil)
+		} else {
+			err = g.Refresh(files, changed, parser, nil)
+		}
+		if err != nil {
+			return err
+		}
+		for path := range inGraph {
+			if _, err = os.Stat(path); err != nil && !g.Has(path) {
+				removed[path] = true
+			}
+		}
+	}
+}
+
+func relPaths(dir string, paths []string) []string {
+	result := make([]string, len(paths))
+	for i, path := range paths {
+		if rel, err := filepath.Rel(dir, path); err == nil {
+			result[i] = rel
+		} else {
+			result[i] = path
+		}
+	}
+	return result
+}
@@ -0,0 +1,9 @@
+package cache
+
+type Config struct {
+	// Enabled tells whether parsed files should be persisted in the cache directory.
+	Enabled bool `yaml:"enabled"`
+	// Dir is the directory where the cache is stored. It defaults to dep-tree's
+	// directory in the user's cache directory, for example, $XDG_CACHE_HOME/dep-tree.
+	Dir string `yaml:"dir"`
+}
@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+// entryFormat must change each time the results of the languages change their shape,
+// so that entries stored in a different format are not reused.
+const entryFormat = "6"
+
+// entry is what gets persisted for each file. Only the results of the language are
+// stored, the language-specific Content of the file is not.
+type entry struct {
+	// Hash identifies the content of the file along with its resolution inputs.
+	Hash string `json:"hash"`
+	// Targets are the files referenced by the imports and exports that existed when
+	// the entry was stored, and Dirs are the directories where the language might have
+	// looked for them. Resolving imports depends on them, like a symbol moving from one
+	// file to another, or a new file shadowing the one an import was resolved to.
+	Targets []string `json:"targets,omitempty"`
+	Dirs    []string `json:"dirs,omitempty"`
+	// Inputs hashes the content of the Targets and the listing of the Dirs. If it does
+	// not match with the current one, the entry is no longer valid.
+	Inputs  string   `json:"inputs,omitempty"`
+	File    *file    `json:"file,omitempty"`
+	Imports *imports `json:"imports,omitempty"`
+	Exports *exports `json:"exports,omitempty"`
+}
+
+type file struct {
+	RelPath       string `json:"relPath"`
+	Package       string `json:"package"`
+	PackageId     string `json:"packageId,omitempty"`
+	Loc           int    `json:"loc"`
+	Size          int    `json:"size"`
+	Types         int    `json:"types,omitempty"`
+	AbstractTypes int    `json:"abstractTypes,omitempty"`
+}
+
+// imports and exports are only stored if they had no errors.
+type imports struct {
+	Imports []language.ImportEntry `json:"imports"`
+}
+
+type exports struct {
+	Exports []language.ExportEntry `json:"exports"`
+}
+
+func (f *file) fileInfo(absPath string) *language.FileInfo {
+	return &language.FileInfo{
+		AbsPath:       absPath,
+		RelPath:       f.RelPath,
+		Package:       f.Package,
+		PackageId:     f.PackageId,
+		Loc:           f.Loc,
+		Size:          f.Size,
+		Types:         f.Types,
+		AbstractTypes: f.AbstractTypes,
+	}
+}
+
+// addTarget tracks a file referenced by the entry, along with the directories where a
+// file shadowing it might appear.
+func (e *entry) addTarget(absPath string) {
+	info, err := os.Stat(absPath)
+	if err != nil {
+		return
+	}
+	if info.IsDir() {
+		e.addDir(absPath)
+	} else if !slices.Contains(e.Targets, absPath) {
+		e.Targets = append(e.Targets, absPath)
+	}
+	e.addDir(filepath.Dir(absPath))
+	e.addDir(filepath.Dir(filepath.Dir(absPath)))
+}
+
+func (e *entry) addDir(dir string) {
+	if !slices.Contains(e.Dirs, dir) {
+		e.Dirs = append(e.Dirs, dir)
+	}
+}
+
+func readEntry(path string) (*entry, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var result entry
+	return &result, json.Unmarshal(content, &result)
+}
+
+// writeEntry writes the entry to a temporary file that is then renamed, so that
+// other dep-tree processes never read half written entries.
+func writeEntry(path string, e *entry) error {
+	content, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,137 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// resolutionInputs are the files that languages read for resolving imports. If
+// one of them changes, the entries of all the files below its directory are
+// invalidated.
+var resolutionInputs = []string{
+	"package.json",
+	"tsconfig.json",
+	"jsconfig.json",
+	"Cargo.toml",
+	"go.mod",
+	"go.work",
+	"pyproject.toml",
+	"setup.py",
+	"composer.json",
+	"pubspec.yaml",
+	filepath.Join(".dart_tool", "package_config.json"),
+	"mix.exs",
+	"settings.gradle",
+	"settings.gradle.kts",
+	"build.gradle",
+	"build.gradle.kts",
+	"buf.work.yaml",
+	"compile_commands.json",
+	"CMakeLists.txt",
+	".terraform.lock.hcl",
+}
+
+func hashBytes(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// dirInputsHash hashes the resolution inputs present in dir and in all of its parents.
+var dirInputsHash, evictDirInputsHash = newDirInputsHash()
+
+func newDirInputsHash() (func(dir string) string, utils.Evict[string]) {
+	var dirInputsHash func(dir string) string
+	var evict utils.Evict[string]
+	dirInputsHash, evict = utils.EvictableCached1In1Out(func(dir string) string {
+		h := sha256.New()
+		if parent := filepath.Dir(dir); parent != dir {
+			h.Write([]byte(dirInputsHash(parent)))
+		}
+		for _, input := range resolutionInputs {
+			content, err := os.ReadFile(filepath.Join(dir, input))
+			if err != nil {
+				continue
+			}
+			h.Write([]byte(input))
+			h.Write([]byte(hashBytes(content)))
+		}
+		return hex.EncodeToString(h.Sum(nil))
+	})
+	return dirInputsHash, evict
+}
+
+// hasInputsOf matches the dirs for which any of the provided paths is a resolution
+// input, either of the dir itself or of any of its parents.
+func hasInputsOf(paths []string) func(dir string) bool {
+	isAnyOf := utils.IsAnyOf(paths)
+	return func(dir string) bool {
+		for {
+			for _, input := range resolutionInputs {
+				if isAnyOf(filepath.Join(dir, input)) {
+					return true
+				}
+			}
+			parent := filepath.Dir(dir)
+			if parent == dir {
+				return false
+			}
+			dir = parent
+		}
+	}
+}
+
+// inputsHash hashes the content of the entry's targets and the listing of its dirs.
+// Both are only read once per language, until they are invalidated.
+func (l *Language) inputsHash(e *entry) string {
+	if len(e.Targets) == 0 && len(e.Dirs) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	for _, target := range e.Targets {
+		h.Write([]byte(target))
+		h.Write([]byte(l.memoizedInput("file\x00"+target, func() string {
+			content, err := os.ReadFile(target)
+			if err != nil {
+				return ""
+			}
+			return hashBytes(content)
+		})))
+	}
+	for _, dir := range e.Dirs {
+		h.Write([]byte(dir))
+		h.Write([]byte(l.memoizedInput("dir\x00"+dir, func() string {
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				return ""
+			}
+			listing := sha256.New()
+			for _, entry := range entries {
+				listing.Write([]byte(entry.Name()))
+				if entry.IsDir() {
+					listing.Write([]byte{'/'})
+				}
+				listing.Write([]byte{0})
+			}
+			return hex.EncodeToString(listing.Sum(nil))
+		})))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (l *Language) memoizedInput(key string, compute func() string) string {
+	l.inputsMu.Lock()
+	result, ok := l.inputs[key]
+	l.inputsMu.Unlock()
+	if ok {
+		return result
+	}
+	result = compute()
+	l.inputsMu.Lock()
+	l.inputs[key] = result
+	l.inputsMu.Unlock()
+	return result
+}
@@ -0,0 +1,241 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+// Language persists the results of another language on disk, so that files that
+// did not change do not need to be parsed again in subsequent runs.
+type Language struct {
+	inner language.Language
+	dir   string
+	key   string
+
+	mu      sync.Mutex
+	entries map[string]*entry
+
+	inputsMu sync.Mutex
+	// inputs memoizes the hashes of the files and dirs that entries depend on.
+	inputs map[string]string
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+var _ io.Closer = &Language{}
+
+// MakeCachedLanguage wraps the provided language with a persistent cache. The key
+// must identify everything that affects how the language parses files, like the
+// dep-tree version or the config, entries stored under a different key are not reused.
+func MakeCachedLanguage(inner language.Language, cfg *Config, key string) (*Language, error) {
+	dir := cfg.Dir
+	if dir == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(cacheDir, "dep-tree")
+	}
+	// some languages compute relative paths from the current working directory.
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	return &Language{
+		inner:   inner,
+		dir:     dir,
+		key:     entryFormat + "\x00" + key + "\x00" + cwd,
+		entries: make(map[string]*entry),
+		inputs:  make(map[string]string),
+	}, nil
+}
+
+func (l *Language) entryPath(absPath string) string {
+	name := hashBytes([]byte(l.key + "\x00" + absPath))
+	return filepath.Join(l.dir, name[:2], name+".json")
+}
+
+// load returns the entry for the file, if the file can be cached. The returned entry
+// has no results if there was nothing valid stored for the file's current content.
+func (l *Language) load(absPath string) *entry {
+	l.mu.Lock()
+	e, ok := l.entries[absPath]
+	l.mu.Unlock()
+	if ok {
+		return e
+	}
+	e = l.read(absPath)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// another goroutine might have loaded the same file in the meantime.
+	if existing, ok := l.entries[absPath]; ok {
+		return existing
+	}
+	l.entries[absPath] = e
+	return e
+}
+
+func (l *Language) read(absPath string) *entry {
+	content, err := os.ReadFile(absPath)
+	if err != nil {
+		// not everything that languages parse is a file, like Terraform's external modules.
+		return nil
+	}
+	h := sha256.New()
+	h.Write([]byte(hashBytes(content)))
+	h.Write([]byte(dirInputsHash(filepath.Dir(absPath))))
+	hash := hex.EncodeToString(h.Sum(nil))
+
+	e, err := readEntry(l.entryPath(absPath))
+	if err != nil || e.Hash != hash || e.Inputs != l.inputsHash(e) {
+		return &entry{Hash: hash}
+	}
+	return e
+}
+
+// store persists the entry after it was modified by update. Failing to write the
+// cache is not fatal, the file will just be parsed again next time.
+func (l *Language) store(absPath string, e *entry, update func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	update()
+	_ = writeEntry(l.entryPath(absPath), e)
+}
+
+func (l *Language) ParseFile(path string) (*language.FileInfo, error) {
+	e := l.load(path)
+	if e == nil {
+		return l.inner.ParseFile(path)
+	}
+	l.mu.Lock()
+	cached := e.File
+	l.mu.Unlock()
+	if cached != nil {
+		return cached.fileInfo(path), nil
+	}
+	result, err := l.inner.ParseFile(path)
+	if err != nil {
+		return nil, err
+	}
+	l.store(path, e, func() {
+		e.File = &file{
+			RelPath:       result.RelPath,
+			Package:       result.Package,
+			PackageId:     result.PackageId,
+			Loc:           result.Loc,
+			Size:          result.Size,
+			Types:         result.Types,
+			AbstractTypes: result.AbstractTypes,
+		}
+	})
+	return result, nil
+}
+
+// parsed returns the file as parsed by the inner language. Files that were read from
+// the cache do not have the language-specific Content, so they need to be parsed again.
+func (l *Language) parsed(file *language.FileInfo) (*language.FileInfo, error) {
+	if file.Content != nil {
+		return file, nil
+	}
+	return l.inner.ParseFile(file.AbsPath)
+}
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	e := l.load(file.AbsPath)
+	if e == nil {
+		return l.inner.ParseImports(file)
+	}
+	l.mu.Lock()
+	cached := e.Imports
+	l.mu.Unlock()
+	if cached != nil {
+		return &language.ImportsResult{Imports: cached.Imports}, nil
+	}
+	parsed, err := l.parsed(file)
+	if err != nil {
+		return nil, err
+	}
+	result, err := l.inner.ParseImports(parsed)
+	if err != nil {
+		return nil, err
+	}
+	// imports that could not be resolved might be resolved to a file created later,
+	// which cannot be tracked, so they are parsed again each time.
+	if len(result.Errors) > 0 {
+		return result, nil
+	}
+	l.store(file.AbsPath, e, func() {
+		e.Imports = &imports{Imports: result.Imports}
+		// files in the same dir might be imported implicitly, like in Go or Java packages.
+		e.addDir(filepath.Dir(file.AbsPath))
+		for _, imported := range result.Imports {
+			e.addTarget(imported.AbsPath)
+		}
+		e.Inputs = l.inputsHash(e)
+	})
+	return result, nil
+}
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	e := l.load(file.AbsPath)
+	if e == nil {
+		return l.inner.ParseExports(file)
+	}
+	l.mu.Lock()
+	cached := e.Exports
+	l.mu.Unlock()
+	if cached != nil {
+		return &language.ExportsResult{Exports: cached.Exports}, nil
+	}
+	parsed, err := l.parsed(file)
+	if err != nil {
+		return nil, err
+	}
+	result, err := l.inner.ParseExports(parsed)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Errors) > 0 {
+		return result, nil
+	}
+	l.store(file.AbsPath, e, func() {
+		e.Exports = &exports{Exports: result.Exports}
+		for _, exported := range result.Exports {
+			if exported.AbsPath != file.AbsPath {
+				e.addTarget(exported.AbsPath)
+			}
+		}
+		e.Inputs = l.inputsHash(e)
+	})
+	return result, nil
+}
+
+func (l *Language) Invalidate(absPaths ...string) {
+	l.mu.Lock()
+	for _, absPath := range absPaths {
+		delete(l.entries, absPath)
+	}
+	l.mu.Unlock()
+	// any of the files or dirs that entries depend on might have changed.
+	l.inputsMu.Lock()
+	l.inputs = make(map[string]string)
+	l.inputsMu.Unlock()
+	evictDirInputsHash(hasInputsOf(absPaths))
+	if invalidator, ok := l.inner.(language.Invalidator); ok {
+		invalidator.Invalidate(absPaths...)
+	}
+}
+
+// Close closes the wrapped language, if it needs to.
+func (l *Language) Close() error {
+	if closer, ok := l.inner.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
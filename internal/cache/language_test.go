@@ -0,0 +1,258 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/dummy"
+	golang "github.com/gabotechs/dep-tree/internal/go"
+	"github.com/gabotechs/dep-tree/internal/js"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/php"
+)
+
+// countingLanguage counts how many times each file was parsed by the dummy language.
+type countingLanguage struct {
+	dummy.Language
+	parsed map[string]int
+}
+
+func (c *countingLanguage) ParseFile(path string) (*language.FileInfo, error) {
+	c.parsed[filepath.Base(path)]++
+	return c.Language.ParseFile(path)
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Change         map[string]string
+		Remove         string
+		ExpectedParsed map[string]int
+		ExpectedDeps   []string
+	}{
+		{
+			Name:           "nothing changed",
+			ExpectedParsed: map[string]int{},
+			ExpectedDeps:   []string{"lib.dl"},
+		},
+		{
+			Name:           "file changed",
+			Change:         map[string]string{"main.dl": "import foo from ./lib.dl\nimport bar from ./other.dl\n"},
+			ExpectedParsed: map[string]int{"main.dl": 1, "other.dl": 1},
+			ExpectedDeps:   []string{"lib.dl", "other.dl"},
+		},
+		{
+			Name:           "resolution input changed",
+			Change:         map[string]string{"package.json": `{"name": "changed"}`},
+			ExpectedParsed: map[string]int{"main.dl": 1, "lib.dl": 1},
+			ExpectedDeps:   []string{"lib.dl"},
+		},
+		{
+			Name:           "imported file removed",
+			Remove:         "lib.dl",
+			ExpectedParsed: map[string]int{"main.dl": 1, "lib.dl": 1},
+			ExpectedDeps:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			dir := t.TempDir()
+			cfg := Config{Enabled: true, Dir: filepath.Join(dir, ".cache")}
+			writeFiles(t, dir, map[string]string{
+				"main.dl":      "import foo from ./lib.dl\n",
+				"lib.dl":       "export foo\n",
+				"other.dl":     "export bar\n",
+				"package.json": `{"name": "test"}`,
+			})
+
+			load := func() (*countingLanguage, []string) {
+				inner := &countingLanguage{parsed: map[string]int{}}
+				lang, err := MakeCachedLanguage(inner, &cfg, "test")
+				a.NoError(err)
+				parser := language.NewParser(lang)
+				node, err := parser.Node(filepath.Join(dir, "main.dl"))
+				a.NoError(err)
+				deps, err := parser.Deps(node)
+				a.NoError(err)
+				result := make([]string, 0)
+				for _, dep := range deps {
+					result = append(result, filepath.Base(dep.Id))
+				}
+				return inner, result
+			}
+
+			inner, _ := load()
+			a.Equal(map[string]int{"main.dl": 1, "lib.dl": 1}, inner.parsed)
+
+			writeFiles(t, dir, tt.Change)
+			if tt.Remove != "" {
+				a.NoError(os.Remove(filepath.Join(dir, tt.Remove)))
+			}
+			// the inputs of the directories are only hashed once per process.
+			evictDirInputsHash(func(string) bool { return true })
+
+			inner, deps := load()
+			a.Equal(tt.ExpectedParsed, inner.parsed)
+			a.Equal(tt.ExpectedDeps, deps)
+		})
+	}
+}
+
+func TestLanguage_Key(t *testing.T) {
+	a := require.New(t)
+	dir := t.TempDir()
+	cfg := Config{Enabled: true, Dir: filepath.Join(dir, ".cache")}
+	writeFiles(t, dir, map[string]string{"main.dl": "export foo\n"})
+
+	for _, step := range []struct {
+		key    string
+		parsed int
+	}{{"a", 1}, {"a", 0}, {"b", 1}} {
+		inner := &countingLanguage{parsed: map[string]int{}}
+		lang, err := MakeCachedLanguage(inner, &cfg, step.key)
+		a.NoError(err)
+		file, err := lang.ParseFile(filepath.Join(dir, "main.dl"))
+		a.NoError(err)
+		_, err = lang.ParseExports(file)
+		a.NoError(err)
+		a.Equal(step.parsed, inner.parsed["main.dl"])
+	}
+}
+
+func TestLanguage_Resolution(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Files        map[string]string
+		Entrypoint   string
+		Change       map[string]string
+		Make         func(dir string) (language.Language, error)
+		ExpectedDeps []string
+	}{
+		{
+			Name: "symbol moved to another file of the package",
+			Files: map[string]string{
+				"go.mod":   "module example\n\ngo 1.21\n",
+				"main.go":  "package main\n\nimport \"example/pkg\"\n\nfunc main() {\n\tpkg.Bar()\n}\n",
+				"pkg/b.go": "package pkg\n\nfunc Bar() {}\n",
+				"pkg/c.go": "package pkg\n\nfunc Baz() {}\n",
+			},
+			Entrypoint: "main.go",
+			Change: map[string]string{
+				"pkg/b.go": "package pkg\n",
+				"pkg/c.go": "package pkg\n\nfunc Baz() {}\n\nfunc Bar() {}\n",
+			},
+			Make: func(dir string) (language.Language, error) {
+				return golang.NewLanguage(dir, &golang.Config{})
+			},
+			ExpectedDeps: []string{"pkg/c.go"},
+		},
+		{
+			Name: "symbol moved to a new file of the package",
+			Files: map[string]string{
+				"go.mod":   "module example\n\ngo 1.21\n",
+				"main.go":  "package main\n\nimport \"example/pkg\"\n\nfunc main() {\n\tpkg.Bar()\n}\n",
+				"pkg/b.go": "package pkg\n\nfunc Bar() {}\n",
+			},
+			Entrypoint: "main.go",
+			Change: map[string]string{
+				"pkg/b.go": "package pkg\n",
+				"pkg/e.go": "package pkg\n\nfunc Bar() {}\n",
+			},
+			Make: func(dir string) (language.Language, error) {
+				return golang.NewLanguage(dir, &golang.Config{})
+			},
+			ExpectedDeps: []string{"pkg/e.go"},
+		},
+		{
+			Name: "new file shadowing the imported one",
+			Files: map[string]string{
+				"package.json": `{"name": "test"}`,
+				"main.ts":      "import { foo } from './foo'\n",
+				"foo.ts":       "export const foo = 1\n",
+			},
+			Entrypoint: "main.ts",
+			Change: map[string]string{
+				"foo/index.ts": "export const foo = 2\n",
+			},
+			Make: func(string) (language.Language, error) {
+				return js.MakeJsLanguage(&js.Config{})
+			},
+			ExpectedDeps: []string{"foo/index.ts"},
+		},
+		{
+			Name: "autoload dir moved in composer.json",
+			Files: map[string]string{
+				"composer.json": `{"autoload": {"psr-4": {"App\\": "src/"}}}`,
+				"main.php":      "<?php\nuse App\\Foo;\n",
+				"src/Foo.php":   "<?php\nnamespace App;\nclass Foo {}\n",
+				"lib/Foo.php":   "<?php\nnamespace App;\nclass Foo {}\n",
+			},
+			Entrypoint: "main.php",
+			Change: map[string]string{
+				"composer.json": `{"autoload": {"psr-4": {"App\\": "lib/"}}}`,
+			},
+			Make: func(string) (language.Language, error) {
+				return php.MakePhpLanguage(&php.Config{})
+			},
+			ExpectedDeps: []string{"lib/Foo.php"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			dir := t.TempDir()
+			cfg := Config{Enabled: true, Dir: filepath.Join(dir, ".cache")}
+			write := func(files map[string]string) {
+				for name, content := range files {
+					path := filepath.Join(dir, name)
+					a.NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+					a.NoError(os.WriteFile(path, []byte(content), 0o644))
+				}
+			}
+			write(tt.Files)
+
+			load := func() (*language.Parser, []string) {
+				inner, err := tt.Make(dir)
+				a.NoError(err)
+				lang, err := MakeCachedLanguage(inner, &cfg, "test")
+				a.NoError(err)
+				parser := language.NewParser(lang)
+				node, err := parser.Node(filepath.Join(dir, tt.Entrypoint))
+				a.NoError(err)
+				deps, err := parser.Deps(node)
+				a.NoError(err)
+				result := make([]string, 0)
+				for _, dep := range deps {
+					rel, _ := filepath.Rel(dir, dep.Id)
+					result = append(result, filepath.ToSlash(rel))
+				}
+				return parser, result
+			}
+
+			parser, _ := load()
+			write(tt.Change)
+			// what the languages memoize lives as long as the process, so it is dropped
+			// as if each load was a different run.
+			var changed []string
+			for name := range tt.Change {
+				changed = append(changed, filepath.Join(dir, name))
+			}
+			parser.Invalidate(changed...)
+
+			_, deps := load()
+			a.Equal(tt.ExpectedDeps, deps)
+		})
+	}
+}
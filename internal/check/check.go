@@ -2,6 +2,7 @@ package check
 
 import (
 	"errors"
+	"fmt"
 	"path/filepath"
 	"strings"
 
@@ -16,61 +17,126 @@ func Check[T any](
 	callbacks graph.LoadCallbacks[T],
 ) error {
 	// 1. build the graph.
-	files := make([]string, len(cfg.Entrypoints))
-	for i, file := range cfg.Entrypoints {
-		files[i] = filepath.Join(cfg.Path, file)
-	}
-
 	g := graph.NewGraph[T]()
-	err := g.Load(files, parser, callbacks)
+	err := g.Load(cfg.EntrypointPaths(), parser, callbacks)
 	if err != nil {
 		return err
 	}
+	return Validate(g, display, cfg)
+}
 
-	// 2. Check for rule violations in the graph.
-	sb := strings.Builder{}
+// EntrypointPaths returns the absolute paths of the configured entrypoints.
+func (c *Config) EntrypointPaths() []string {
+	files := make([]string, len(c.Entrypoints))
+	for i, file := range c.Entrypoints {
+		files[i] = filepath.Join(c.Path, file)
+	}
+	return files
+}
+
+// Violation is a dependency between two files that is not allowed by the rules.
+type Violation struct {
+	// From and To are the paths of the files relative to the config file.
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Reason is the reason given in the broken rule, if any.
+	Reason string `json:"reason,omitempty"`
+	// Data tells why From depends on To, it might be nil if it's not known.
+	Data *graph.EdgeData `json:"-"`
+}
+
+// Violations returns the dependencies in an already loaded graph that are not allowed by
+// the rules, without modifying it.
+func Violations[T any](g *graph.Graph[T], cfg *Config) ([]Violation, error) {
+	var result []Violation
 	for _, node := range g.AllNodes() {
 		for _, dep := range g.FromId(node.Id) {
 			from, to := cfg.rel(node.Id), cfg.rel(dep.Id)
 			pass, reason, err := cfg.Check(from, to)
 			if err != nil {
-				return err
+				return nil, err
 			} else if !pass {
-				sb.WriteString("- ")
-				sb.WriteString(from)
-				sb.WriteString(" -> ")
-				sb.WriteString(to)
-				if reason != "" {
-					for _, line := range strings.Split(reason, "\n") {
-						sb.WriteString("\n  ")
-						sb.WriteString(line)
-					}
-				}
-				sb.WriteString("\n")
+				result = append(result, Violation{
+					From:   from,
+					To:     to,
+					Reason: reason,
+					Data:   g.EdgeData(node.Id, dep.Id),
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+// Validate checks an already loaded graph against the rules, without modifying it.
+func Validate[T any](
+	g *graph.Graph[T],
+	display func(node *graph.Node[T]) string,
+	cfg *Config,
+) error {
+	// 2. Check for rule violations in the graph.
+	violations, err := Violations(g, cfg)
+	if err != nil {
+		return err
+	}
+	sb := strings.Builder{}
+	for _, violation := range violations {
+		sb.WriteString("- ")
+		sb.WriteString(violation.From)
+		if line := violation.Data.Line(); line > 0 {
+			sb.WriteString(fmt.Sprintf(":%d", line))
+		}
+		sb.WriteString(" -> ")
+		sb.WriteString(violation.To)
+		if description := violation.Data.Describe(); description != "" {
+			sb.WriteString(" (" + description + ")")
+		}
+		if violation.Reason != "" {
+			for _, line := range strings.Split(violation.Reason, "\n") {
+				sb.WriteString("\n  ")
+				sb.WriteString(line)
 			}
 		}
+		sb.WriteString("\n")
 	}
-	// 3. Check for cycles.
-	cycles := g.RemoveElementaryCycles()
+	// 3. Check for tangles, groups of files that depend on each other.
 	if !cfg.AllowCircularDependencies {
-		if len(cycles) > 0 {
+		tangles := g.Tangles(cfg.MaxCycles)
+		if len(tangles) > 0 {
 			sb.WriteString("\n")
 			sb.WriteString("detected circular dependencies:")
 			sb.WriteString("\n")
 		}
-		for _, cycle := range cycles {
-			formattedCycleStack := make([]string, len(cycle.Stack))
-			for i, el := range cycle.Stack {
+		formatIds := func(ids []string) []string {
+			formatted := make([]string, len(ids))
+			for i, el := range ids {
 				if node := g.Get(el); node != nil {
-					formattedCycleStack[i] = display(node)
+					formatted[i] = display(node)
 				} else {
-					formattedCycleStack[i] = el
+					formatted[i] = el
 				}
 			}
-
-			sb.WriteString("- ")
-			sb.WriteString(strings.Join(formattedCycleStack, " -> "))
+			return formatted
+		}
+		// each file in a cycle is followed by the line where it imports the next one.
+		formatCycle := func(stack []string) []string {
+			formatted := formatIds(stack)
+			for i := 0; i+1 < len(stack); i++ {
+				if line := g.EdgeData(stack[i], stack[i+1]).Line(); line > 0 {
+					formatted[i] += fmt.Sprintf(":%d", line)
+				}
+			}
+			return formatted
+		}
+		for _, tangle := range tangles {
+			sb.WriteString(fmt.Sprintf("- %d files depend on each other: ", len(tangle.Nodes)))
+			sb.WriteString(strings.Join(formatIds(tangle.Nodes), ", "))
 			sb.WriteString("\n")
+			for _, cycle := range tangle.Cycles {
+				sb.WriteString("  ")
+				sb.WriteString(strings.Join(formatCycle(cycle.Stack), " -> "))
+				sb.WriteString("\n")
+			}
 		}
 	}
 	errorMsg := sb.String()
@@ -32,6 +32,7 @@ func TestCheck(t *testing.T) {
 				BlackList: map[string][]BlackListEntry{
 					"0": {{To: "3"}},
 				},
+				MaxCycles: 1,
 			},
 			Failure: `
 Check failed, the following dependencies are not allowed:
@@ -39,7 +40,8 @@ Check failed, the following dependencies are not allowed:
 - 4 -> 3
 
 detected circular dependencies:
-- 4 -> 3 -> 4`,
+- 2 files depend on each other: 3, 4
+  3 -> 4 -> 3`,
 		},
 		{
 			Name: "With description",
@@ -67,7 +69,42 @@ Check failed, the following dependencies are not allowed:
   4 Should not be importing anything
 
 detected circular dependencies:
-- 4 -> 3 -> 4`,
+- 2 files depend on each other: 3, 4`,
+		},
+		{
+			Name: "Multiple tangles",
+			Spec: [][]int{
+				0: {1, 3},
+				1: {2},
+				2: {1},
+				3: {4, 5},
+				4: {3},
+				5: {3, 4},
+			},
+			Config: &Config{
+				Entrypoints: []string{"0"},
+				MaxCycles:   2,
+			},
+			Failure: `
+Check failed, the following dependencies are not allowed:
+
+detected circular dependencies:
+- 2 files depend on each other: 1, 2
+  1 -> 2 -> 1
+- 3 files depend on each other: 3, 4, 5
+  3 -> 4 -> 3
+  3 -> 5 -> 3`,
+		},
+		{
+			Name: "Allowed circular dependencies",
+			Spec: [][]int{
+				0: {1},
+				1: {0},
+			},
+			Config: &Config{
+				Entrypoints:               []string{"0"},
+				AllowCircularDependencies: true,
+			},
 		},
 	}
 
@@ -86,7 +123,32 @@ detected circular dependencies:
 					strings.TrimSpace(tt.Failure),
 					strings.TrimSpace(err.Error()),
 				)
+			} else {
+				a.NoError(err)
 			}
 		})
 	}
 }
+
+func TestValidate_EdgeData(t *testing.T) {
+	a := require.New(t)
+	g := graph.MakeTestGraph([][]int{
+		0: {1},
+		1: {0},
+	})
+	a.NoError(g.AddEdge("0", "1", &graph.EdgeData{Imports: []graph.EdgeImport{{Symbols: []string{"foo", "bar"}, Line: 12}}}))
+	a.NoError(g.AddEdge("1", "0", &graph.EdgeData{Imports: []graph.EdgeImport{{All: true, Line: 3}}}))
+
+	err := Validate(g, func(node *graph.Node[int]) string { return node.Id }, &Config{
+		BlackList: map[string][]BlackListEntry{
+			"0": {{To: "1"}},
+		},
+		MaxCycles: 1,
+	})
+	a.Equal(`Check failed, the following dependencies are not allowed:
+- 0:12 -> 1 (imports foo, bar)
+
+detected circular dependencies:
+- 2 files depend on each other: 0, 1
+  0:12 -> 1:3 -> 0`, strings.TrimSpace(err.Error()))
+}
@@ -7,6 +7,9 @@ type Config struct {
 	Aliases                   map[string][]string         `yaml:"aliases"`
 	WhiteList                 map[string]WhiteListEntries `yaml:"allow"`
 	BlackList                 map[string][]BlackListEntry `yaml:"deny"`
+	// MaxCycles is the maximum amount of circular dependencies shown for each group of files
+	// that depend on each other.
+	MaxCycles int `yaml:"-"`
 }
 
 func (c *Config) Init(path string) {
@@ -6,15 +6,32 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/gabotechs/dep-tree/internal/utils"
 	"gopkg.in/yaml.v3"
 
+	"github.com/gabotechs/dep-tree/internal/cache"
 	"github.com/gabotechs/dep-tree/internal/check"
+	"github.com/gabotechs/dep-tree/internal/cpp"
+	"github.com/gabotechs/dep-tree/internal/csharp"
+	"github.com/gabotechs/dep-tree/internal/css"
+	"github.com/gabotechs/dep-tree/internal/dart"
+	"github.com/gabotechs/dep-tree/internal/elixir"
 	golang "github.com/gabotechs/dep-tree/internal/go"
+	"github.com/gabotechs/dep-tree/internal/java"
 	"github.com/gabotechs/dep-tree/internal/js"
+	"github.com/gabotechs/dep-tree/internal/kotlin"
+	"github.com/gabotechs/dep-tree/internal/mixed"
+	"github.com/gabotechs/dep-tree/internal/php"
+	"github.com/gabotechs/dep-tree/internal/plugin"
+	"github.com/gabotechs/dep-tree/internal/protobuf"
 	"github.com/gabotechs/dep-tree/internal/python"
+	"github.com/gabotechs/dep-tree/internal/ruby"
 	"github.com/gabotechs/dep-tree/internal/rust"
+	"github.com/gabotechs/dep-tree/internal/terraform"
+	"github.com/gabotechs/dep-tree/internal/unused"
 )
 
 const DefaultConfigPath = ".dep-tree.yml"
@@ -25,14 +42,31 @@ var SampleConfig string
 type Config struct {
 	Path          string
 	Source        string
-	Exclude       []string      `yaml:"exclude"`
-	Only          []string      `yaml:"only"`
-	UnwrapExports bool          `yaml:"unwrapExports"`
-	Check         check.Config  `yaml:"check"`
-	Js            js.Config     `yaml:"js"`
-	Rust          rust.Config   `yaml:"rust"`
-	Python        python.Config `yaml:"python"`
-	Golang        golang.Config `yaml:"golang"`
+	File          string           `yaml:"-"`
+	Jobs          int              `yaml:"-"`
+	Exclude       []string         `yaml:"exclude"`
+	Only          []string         `yaml:"only"`
+	UnwrapExports bool             `yaml:"unwrapExports"`
+	Check         check.Config     `yaml:"check"`
+	Unused        unused.Config    `yaml:"unused"`
+	Cache         cache.Config     `yaml:"cache"`
+	Mixed         mixed.Config     `yaml:"mixed"`
+	Plugins       []plugin.Config  `yaml:"plugins"`
+	Js            js.Config        `yaml:"js"`
+	Rust          rust.Config      `yaml:"rust"`
+	Python        python.Config    `yaml:"python"`
+	Golang        golang.Config    `yaml:"golang"`
+	Java          java.Config      `yaml:"java"`
+	Cpp           cpp.Config       `yaml:"cpp"`
+	Csharp        csharp.Config    `yaml:"csharp"`
+	Kotlin        kotlin.Config    `yaml:"kotlin"`
+	Ruby          ruby.Config      `yaml:"ruby"`
+	Php           php.Config       `yaml:"php"`
+	Css           css.Config       `yaml:"css"`
+	Protobuf      protobuf.Config  `yaml:"protobuf"`
+	Terraform     terraform.Config `yaml:"terraform"`
+	Dart          dart.Config      `yaml:"dart"`
+	Elixir        elixir.Config    `yaml:"elixir"`
 }
 
 func NewConfigCwd() Config {
@@ -41,17 +75,73 @@ func NewConfigCwd() Config {
 }
 
 func (c *Config) EnsureAbsPaths() {
-	for i, file := range c.Exclude {
-		if !filepath.IsAbs(file) {
-			c.Exclude[i] = filepath.Join(c.Path, file)
+	c.mapPaths(func(path string) string {
+		if filepath.IsAbs(path) {
+			return path
 		}
+		return filepath.Join(c.Path, path)
+	})
+	c.Unused.EnsureAbsPaths(c.Path)
+}
+
+// Rebase returns a copy of the config where all the absolute paths inside the from
+// directory are moved to the to directory, keeping their relative location.
+func (c *Config) Rebase(from, to string) *Config {
+	rebase := func(path string) string {
+		rel, err := filepath.Rel(from, path)
+		if err != nil || !filepath.IsAbs(path) || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return path
+		}
+		return filepath.Join(to, rel)
+	}
+	result := *c
+	result.Path = rebase(c.Path)
+	result.File = rebase(c.File)
+	result.Check.Path = rebase(c.Check.Path)
+	result.Unused.Files = utils.Map(c.Unused.Files, rebase)
+	result.Unused.Allow = utils.Map(c.Unused.Allow, rebase)
+	result.mapPaths(rebase)
+	return &result
+}
+
+// mapPaths replaces all the paths in the config, except the ones under the check and unused
+// sections, with the result of f. Slices are never modified in place.
+func (c *Config) mapPaths(f func(path string) string) {
+	c.Exclude = utils.Map(c.Exclude, f)
+	c.Only = utils.Map(c.Only, f)
+
+	edges := make([]mixed.Edge, len(c.Mixed.Edges))
+	for i, edge := range c.Mixed.Edges {
+		edges[i] = mixed.Edge{From: f(edge.From), To: utils.Map(edge.To, f)}
+	}
+	if c.Mixed.Edges != nil {
+		c.Mixed.Edges = edges
+	}
+
+	if c.Cache.Dir != "" {
+		c.Cache.Dir = f(c.Cache.Dir)
 	}
 
-	for i, file := range c.Only {
-		if !filepath.IsAbs(file) {
-			c.Only[i] = filepath.Join(c.Path, file)
+	plugins := make([]plugin.Config, len(c.Plugins))
+	for i, p := range c.Plugins {
+		plugins[i] = p
+		if strings.ContainsRune(p.Command, filepath.Separator) {
+			plugins[i].Command = f(p.Command)
 		}
 	}
+	if c.Plugins != nil {
+		c.Plugins = plugins
+	}
+
+	c.Java.SourceRoots = utils.Map(c.Java.SourceRoots, f)
+	c.Cpp.IncludeDirs = utils.Map(c.Cpp.IncludeDirs, f)
+	if c.Cpp.CompileCommands != "" {
+		c.Cpp.CompileCommands = f(c.Cpp.CompileCommands)
+	}
+	c.Ruby.LoadPaths = utils.Map(c.Ruby.LoadPaths, f)
+	c.Ruby.AutoloadPaths = utils.Map(c.Ruby.AutoloadPaths, f)
+	c.Css.LoadPaths = utils.Map(c.Css.LoadPaths, f)
+	c.Protobuf.ProtoRoots = utils.Map(c.Protobuf.ProtoRoots, f)
 }
 
 func (c *Config) ValidatePatterns() error {
@@ -67,6 +157,18 @@ func (c *Config) ValidatePatterns() error {
 		}
 	}
 
+	for _, pattern := range append(slices.Clone(c.Unused.Files), c.Unused.Allow...) {
+		if _, err := utils.GlobstarMatch(pattern, ""); err != nil {
+			return fmt.Errorf("unused pattern '%s' is not correctly formatted", pattern)
+		}
+	}
+
+	for _, edge := range c.Mixed.Edges {
+		if _, err := utils.GlobstarMatch(edge.From, ""); err != nil {
+			return fmt.Errorf("mixed edge pattern '%s' is not correctly formatted", edge.From)
+		}
+	}
+
 	return nil
 }
 
@@ -96,6 +198,7 @@ func ParseConfigFromFile(cfgPath string) (*Config, error) {
 		return nil, err
 	}
 	cfg.Path = filepath.Dir(absCfgPath)
+	cfg.File = absCfgPath
 	cfg.Check.Path = cfg.Path
 
 	// If a specific path was requested, and it does not exist, fail
@@ -148,3 +148,20 @@ func TestSampleConfig(t *testing.T) {
 	_, err := ParseConfigFromFile("sample-config.yml")
 	a.NoError(err)
 }
+
+func TestConfig_Rebase(t *testing.T) {
+	a := require.New(t)
+	cfg := NewConfigCwd()
+	cfg.Path = "/repo"
+	cfg.File = "/repo/.dep-tree.yml"
+	cfg.Check.Path = "/repo"
+	cfg.Exclude = []string{"/repo/generated/**", "/other/**"}
+
+	rebased := cfg.Rebase("/repo", "/tmp/rev")
+	a.Equal("/tmp/rev", rebased.Path)
+	a.Equal("/tmp/rev/.dep-tree.yml", rebased.File)
+	a.Equal("/tmp/rev", rebased.Check.Path)
+	a.Equal([]string{"/tmp/rev/generated/**", "/other/**"}, rebased.Exclude)
+	// the original config is left untouched.
+	a.Equal([]string{"/repo/generated/**", "/other/**"}, cfg.Exclude)
+}
@@ -0,0 +1,4 @@
+#pragma once
+#include <vendor.h>
+
+int add(int a, int b);
@@ -0,0 +1,10 @@
+#include "config.h"
+#include <math/add.h>
+#include <vector>
+#include "vendor.h"
+#include "missing.h"
+
+int main() {
+    std::vector<int> v;
+    return add(1, 2);
+}
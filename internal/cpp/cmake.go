@@ -0,0 +1,46 @@
+package cpp
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+const cmakeListsFile = "CMakeLists.txt"
+
+type CMakeTarget struct {
+	// Name is the first target declared in the CMakeLists.txt file, or the name
+	// of the directory if it declares none.
+	Name string
+	// AbsDir is the directory where the CMakeLists.txt file is located.
+	AbsDir string
+}
+
+var cmakeTargetRegex = regexp.MustCompile(`(?i)add_(?:library|executable)\s*\(\s*([A-Za-z0-9_.+\-]+)`)
+
+// _findClosestCMakeTarget starts from a search path and goes up dir by dir
+// until a CMakeLists.txt file is found. If none is found, it returns nil.
+func _findClosestCMakeTarget(searchPath string) *CMakeTarget {
+	cmakeListsPath := filepath.Join(searchPath, cmakeListsFile)
+	if utils.FileExists(cmakeListsPath) {
+		target := CMakeTarget{Name: filepath.Base(searchPath), AbsDir: searchPath}
+		content, err := os.ReadFile(cmakeListsPath)
+		if err != nil {
+			return &target
+		}
+		if match := cmakeTargetRegex.FindSubmatch(content); match != nil {
+			target.Name = string(match[1])
+		}
+		return &target
+	}
+	nextSearchPath := filepath.Dir(searchPath)
+	if nextSearchPath != searchPath {
+		return _findClosestCMakeTarget(nextSearchPath)
+	} else {
+		return nil
+	}
+}
+
+var findClosestCMakeTarget, evictCMakeTargets = utils.EvictableCached1In1Out(_findClosestCMakeTarget)
@@ -0,0 +1,146 @@
+package cpp
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+const compileCommandsFile = "compile_commands.json"
+
+type compileCommand struct {
+	Directory string   `json:"directory"`
+	File      string   `json:"file"`
+	Command   string   `json:"command"`
+	Arguments []string `json:"arguments"`
+}
+
+// CompileCommands holds the include directories declared in a compile_commands.json
+// compilation database.
+type CompileCommands struct {
+	// byFile are the include directories used for compiling each translation unit.
+	byFile map[string][]string
+	// all are the include directories used by any translation unit. Headers are not
+	// compiled on their own, so they are resolved against these.
+	all []string
+}
+
+// IncludeDirs returns the include directories with which a file is compiled.
+func (c *CompileCommands) IncludeDirs(absPath string) []string {
+	if dirs, ok := c.byFile[absPath]; ok {
+		return dirs
+	}
+	return c.all
+}
+
+// includeFlags are the compiler flags that add a directory to the include search path.
+var includeFlags = []string{"-I", "-isystem", "-iquote", "-idirafter"}
+
+// msvcIncludeFlags are the same as includeFlags, but for MSVC-style compilers. They are
+// not accepted for other compilers, as they would collide with absolute paths like /Include.
+var msvcIncludeFlags = append([]string{"/I"}, includeFlags...)
+
+// isMsvc tells if the compiler invoked by the command is cl or clang-cl.
+func isMsvc(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	compiler := args[0][strings.LastIndexAny(args[0], `/\`)+1:]
+	compiler = strings.TrimSuffix(strings.ToLower(compiler), ".exe")
+	return compiler == "cl" || compiler == "clang-cl"
+}
+
+func parseIncludeDirs(args []string, dir string) []string {
+	flags := includeFlags
+	if isMsvc(args) {
+		flags = msvcIncludeFlags
+	}
+	var result []string
+	for i := 0; i < len(args); i++ {
+		for _, flag := range flags {
+			if !strings.HasPrefix(args[i], flag) {
+				continue
+			}
+			includeDir := strings.TrimPrefix(args[i], flag)
+			if includeDir == "" && i+1 < len(args) {
+				i++
+				includeDir = args[i]
+			}
+			if includeDir == "" {
+				break
+			}
+			if !filepath.IsAbs(includeDir) {
+				includeDir = filepath.Join(dir, includeDir)
+			}
+			result = append(result, filepath.Clean(includeDir))
+			break
+		}
+	}
+	return result
+}
+
+func _readCompileCommands(path string) (*CompileCommands, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var commands []compileCommand
+	err = json.Unmarshal(content, &commands)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %q: %w", path, err)
+	}
+
+	result := CompileCommands{byFile: map[string][]string{}}
+	seen := map[string]struct{}{}
+	for _, command := range commands {
+		dir := command.Directory
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(filepath.Dir(path), dir)
+		}
+		args := command.Arguments
+		if len(args) == 0 {
+			args = strings.Fields(command.Command)
+		}
+		file := command.File
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+		includeDirs := parseIncludeDirs(args, dir)
+		result.byFile[filepath.Clean(file)] = includeDirs
+		for _, includeDir := range includeDirs {
+			if _, ok := seen[includeDir]; !ok {
+				seen[includeDir] = struct{}{}
+				result.all = append(result.all, includeDir)
+			}
+		}
+	}
+	return &result, nil
+}
+
+var readCompileCommands, evictCompileCommands = utils.EvictableCached1In1OutErr(_readCompileCommands)
+
+// _findCompileCommands starts from a search path and goes up dir by dir until a
+// compile_commands.json file is found, either directly in the directory or in its
+// build/ subdirectory, which is where CMake places it by default.
+func _findCompileCommands(searchPath string) string {
+	for _, candidate := range []string{
+		filepath.Join(searchPath, compileCommandsFile),
+		filepath.Join(searchPath, "build", compileCommandsFile),
+	} {
+		if utils.FileExists(candidate) {
+			return candidate
+		}
+	}
+	nextSearchPath := filepath.Dir(searchPath)
+	if nextSearchPath != searchPath {
+		return _findCompileCommands(nextSearchPath)
+	} else {
+		return ""
+	}
+}
+
+var findCompileCommands, evictFindCompileCommands = utils.EvictableCached1In1Out(_findCompileCommands)
@@ -0,0 +1,72 @@
+package cpp
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIncludeDirs(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Args     []string
+		Expected []string
+	}{
+		{
+			Name:     "joined flags",
+			Args:     []string{"cc", "-Ifoo", "-isystem/usr/include", "-c", "main.c"},
+			Expected: []string{"/project/foo", "/usr/include"},
+		},
+		{
+			Name:     "separated flags",
+			Args:     []string{"cc", "-I", "foo", "-iquote", "../bar", "-o", "main.o"},
+			Expected: []string{"/project/foo", "/bar"},
+		},
+		{
+			Name:     "msvc flags",
+			Args:     []string{`C:\VS\bin\cl.exe`, "/Ifoo", "/I", "bar", "/c", "main.c"},
+			Expected: []string{"/project/foo", "/project/bar"},
+		},
+		{
+			Name:     "absolute paths starting with /I",
+			Args:     []string{"/usr/bin/cc", "-Ifoo", "/Include/main.c", "-c", "/Include/other.c"},
+			Expected: []string{"/project/foo"},
+		},
+		{
+			Name:     "dangling flag",
+			Args:     []string{"cc", "-I"},
+			Expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			a.Equal(tt.Expected, parseIncludeDirs(tt.Args, "/project"))
+		})
+	}
+}
+
+func TestReadCompileCommands(t *testing.T) {
+	a := require.New(t)
+	absTestFolder, _ := filepath.Abs(testFolder)
+
+	compileCommands, err := readCompileCommands(filepath.Join(absTestFolder, compileCommandsFile))
+	a.NoError(err)
+
+	a.Equal(
+		[]string{
+			filepath.Join(absTestFolder, "include"),
+			filepath.Join(absTestFolder, "third_party", "include"),
+		},
+		compileCommands.IncludeDirs(filepath.Join(absTestFolder, "src", "main.cpp")),
+	)
+	a.Equal(
+		[]string{
+			filepath.Join(absTestFolder, "include"),
+			filepath.Join(absTestFolder, "third_party", "include"),
+		},
+		compileCommands.IncludeDirs(filepath.Join(absTestFolder, "src", "config.h")),
+	)
+}
@@ -0,0 +1,6 @@
+package cpp
+
+type Config struct {
+	IncludeDirs     []string `yaml:"includeDirs"`
+	CompileCommands string   `yaml:"compileCommands"`
+}
@@ -0,0 +1,55 @@
+//nolint:govet
+package cpp_grammar
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+type Statement struct {
+	Include *Include `@@`
+}
+
+type File struct {
+	Statements []*Statement `(@@ | ANY | Ident | String | SystemHeader)*`
+}
+
+var (
+	lex = lexer.MustSimple(
+		[]lexer.SimpleRule{
+			{"Comment", `//.*|/\*(.|\n)*?\*/`},
+			{"String", `"(?:\\.|[^"\\\n])*"` + "|" + `'(?:\\.|[^'\\\n])*'`},
+			{"Include", `#[ \t]*include(_next)?\b`},
+			{"SystemHeader", `<[^<>\n]*>`},
+			{"Ident", `[_a-zA-Z][_a-zA-Z0-9]*`},
+			{"Whitespace", `\s+`},
+			{"ANY", `.`},
+		},
+	)
+	parser = participle.MustBuild[File](
+		participle.Lexer(lex),
+		participle.Elide("Whitespace", "Comment"),
+		participle.UseLookahead(2),
+	)
+)
+
+func Parse(filePath string) (*language.FileInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseBytes(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	return &language.FileInfo{
+		Content: file,
+		Loc:     bytes.Count(content, []byte("\n")),
+		Size:    len(content),
+		AbsPath: filePath,
+	}, nil
+}
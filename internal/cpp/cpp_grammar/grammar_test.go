@@ -0,0 +1,63 @@
+package cpp_grammar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		Name             string
+		ExpectedIncludes []string
+		ExpectedSystem   []bool
+	}{
+		{
+			Name:             `#include "foo.h"`,
+			ExpectedIncludes: []string{"foo.h"},
+			ExpectedSystem:   []bool{false},
+		},
+		{
+			Name:             `#include <vector>`,
+			ExpectedIncludes: []string{"vector"},
+			ExpectedSystem:   []bool{true},
+		},
+		{
+			Name:             "#  include <sys/types.h>\n#include_next \"bar/baz.hpp\"",
+			ExpectedIncludes: []string{"sys/types.h", "bar/baz.hpp"},
+			ExpectedSystem:   []bool{true, false},
+		},
+		{
+			Name:             "// #include \"commented.h\"\n/* #include <also.h> */\n#include \"real.h\"",
+			ExpectedIncludes: []string{"real.h"},
+			ExpectedSystem:   []bool{false},
+		},
+		{
+			Name:             "#include \"a.h\"\nint main() { std::vector<int> v; if (a < b && c > d) {} const char* s = \"#include <no.h>\"; }",
+			ExpectedIncludes: []string{"a.h"},
+			ExpectedSystem:   []bool{false},
+		},
+		{
+			Name: "#define INCLUDE_ME \"x.h\"\nint x = '#';",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			parsed, err := parser.ParseBytes("", []byte(tt.Name))
+			a.NoError(err)
+
+			var includes []string
+			var system []bool
+			for _, stmt := range parsed.Statements {
+				if stmt.Include != nil {
+					includes = append(includes, stmt.Include.Path())
+					system = append(system, stmt.Include.System())
+				}
+			}
+			a.Equal(tt.ExpectedIncludes, includes)
+			a.Equal(tt.ExpectedSystem, system)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+//nolint:govet
+package cpp_grammar
+
+import "github.com/alecthomas/participle/v2/lexer"
+
+type Include struct {
+	Pos lexer.Position
+	Raw string `Include @(String | SystemHeader)`
+}
+
+// Path is the path inside the include directive, without quotes or angle brackets.
+func (i *Include) Path() string {
+	return i.Raw[1 : len(i.Raw)-1]
+}
+
+// System is true for `#include <foo.h>` directives, which are not looked up
+// relative to the including file.
+func (i *Include) System() bool {
+	return i.Raw[0] == '<'
+}
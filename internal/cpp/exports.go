@@ -0,0 +1,13 @@
+package cpp
+
+import (
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+// ParseExports returns no exports, as C and C++ do not export symbols from files, they
+// just declare them in headers that are textually included by other files.
+func (l *Language) ParseExports(_ *language.FileInfo) (*language.ExportsResult, error) {
+	return &language.ExportsResult{
+		Exports: make([]language.ExportEntry, 0),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package cpp
+
+import (
+	"github.com/gabotechs/dep-tree/internal/cpp/cpp_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	imports := make([]language.ImportEntry, 0)
+	var errors []error
+
+	includeDirs, err := l.includeDirs(file.AbsPath)
+	if err != nil {
+		errors = append(errors, err)
+	}
+
+	content := file.Content.(*cpp_grammar.File)
+	for _, stmt := range content.Statements {
+		if stmt.Include == nil {
+			continue
+		}
+		resolved := resolve(stmt.Include.Path(), stmt.Include.System(), file.AbsPath, includeDirs)
+		if resolved != "" {
+			// An include pastes the whole header, nothing specific is imported.
+			entry := language.EmptyImport(resolved)
+			entry.Line, entry.Column = stmt.Include.Pos.Line, stmt.Include.Pos.Column
+			imports = append(imports, entry)
+		}
+	}
+
+	return &language.ImportsResult{
+		Imports: imports,
+		Errors:  errors,
+	}, nil
+}
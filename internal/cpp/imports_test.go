@@ -0,0 +1,87 @@
+package cpp
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".cpp_test"
+
+func TestLanguage_ParseImports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+
+	tests := []struct {
+		Name     string
+		File     string
+		Config   *Config
+		Expected []language.ImportEntry
+	}{
+		{
+			Name: "main.cpp",
+			File: filepath.Join("src", "main.cpp"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "src", "config.h")), 1, 1),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "include", "math", "add.h")), 2, 1),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "third_party", "include", "vendor.h")), 4, 1),
+			},
+		},
+		{
+			Name:   "main.cpp with include dirs",
+			File:   filepath.Join("src", "main.cpp"),
+			Config: &Config{IncludeDirs: []string{absTestFolder}},
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "src", "config.h")), 1, 1),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "include", "math", "add.h")), 2, 1),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "third_party", "include", "vendor.h")), 4, 1),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "missing.h")), 5, 1),
+			},
+		},
+		{
+			Name: "add.cpp",
+			File: filepath.Join("lib", "math", "add.cpp"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "include", "math", "add.h")), 1, 1),
+			},
+		},
+		{
+			Name: "add.h, not present in the compilation database",
+			File: filepath.Join("include", "math", "add.h"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "third_party", "include", "vendor.h")), 2, 1),
+			},
+		},
+		{
+			Name:   "add.h with explicit compile commands",
+			File:   filepath.Join("include", "math", "add.h"),
+			Config: &Config{CompileCommands: filepath.Join(absTestFolder, "compile_commands.json")},
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "third_party", "include", "vendor.h")), 2, 1),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeCppLanguage(tt.Config)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(filepath.Join(absTestFolder, tt.File))
+			a.NoError(err)
+
+			imports, err := lang.ParseImports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, imports.Imports)
+			a.Nil(imports.Errors)
+		})
+	}
+}
+
+func at(entry language.ImportEntry, line, column int) language.ImportEntry {
+	entry.Line, entry.Column = line, column
+	return entry
+}
@@ -0,0 +1,75 @@
+package cpp
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/cpp/cpp_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var Extensions = []string{
+	"c", "h",
+	"cc", "cpp", "cxx", "c++",
+	"hh", "hpp", "hxx", "h++",
+	"inl", "ipp",
+}
+
+type Language struct {
+	cfg *Config
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+
+func MakeCppLanguage(cfg *Config) (language.Language, error) {
+	lang := Language{
+		cfg: cfg,
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+	return &lang, nil
+}
+
+var findProjectRoot = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	compileCommandsFile,
+	".git/index",
+})
+
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	file, err := cpp_grammar.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	target := findClosestCMakeTarget(filepath.Dir(id))
+	if target != nil {
+		file.Package = target.Name
+	}
+	switch root := findProjectRoot(filepath.Dir(id)); {
+	case root != nil:
+		file.RelPath, _ = filepath.Rel(root.AbsDir, id)
+	case target != nil:
+		file.RelPath, _ = filepath.Rel(target.AbsDir, id)
+	default:
+		currentDir, _ := os.Getwd()
+		file.RelPath, _ = filepath.Rel(currentDir, id)
+	}
+	return file, nil
+}
+
+// Invalidate drops the compile_commands.json and CMakeLists.txt files that are among
+// the provided files. As they are looked up from every dir above the source files, any
+// of them being created or removed drops all the lookups.
+func (l *Language) Invalidate(absPaths ...string) {
+	evictCompileCommands(utils.IsAnyOf(absPaths))
+	for _, absPath := range absPaths {
+		switch filepath.Base(absPath) {
+		case compileCommandsFile:
+			evictFindCompileCommands(func(string) bool { return true })
+		case cmakeListsFile:
+			evictCMakeTargets(func(string) bool { return true })
+		}
+	}
+}
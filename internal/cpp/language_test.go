@@ -0,0 +1,43 @@
+package cpp
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguage_ParseFile(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Path            string
+		ExpectedRelPath string
+		ExpectedPackage string
+	}{
+		{
+			Name:            "root CMake target",
+			Path:            filepath.Join(testFolder, "src", "main.cpp"),
+			ExpectedRelPath: "src/main.cpp",
+			ExpectedPackage: "app",
+		},
+		{
+			Name:            "nested CMake target",
+			Path:            filepath.Join(testFolder, "lib", "math", "add.cpp"),
+			ExpectedRelPath: "lib/math/add.cpp",
+			ExpectedPackage: "math",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeCppLanguage(nil)
+			a.NoError(err)
+			absPath, _ := filepath.Abs(tt.Path)
+			file, err := lang.ParseFile(absPath)
+			a.NoError(err)
+			a.Equal(tt.ExpectedPackage, file.Package)
+			a.Equal(tt.ExpectedRelPath, file.RelPath)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package cpp
+
+import (
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// includeDirs returns the directories against which the includes of a file are
+// resolved: first the ones in the config, then the ones declared for that file
+// in the compilation database.
+func (l *Language) includeDirs(absPath string) ([]string, error) {
+	dirs := append([]string{}, l.cfg.IncludeDirs...)
+
+	compileCommandsPath := l.cfg.CompileCommands
+	if compileCommandsPath == "" {
+		compileCommandsPath = findCompileCommands(filepath.Dir(absPath))
+	}
+	if compileCommandsPath == "" {
+		return dirs, nil
+	}
+	compileCommands, err := readCompileCommands(compileCommandsPath)
+	if err != nil {
+		return dirs, err
+	}
+	return append(dirs, compileCommands.IncludeDirs(absPath)...), nil
+}
+
+// resolve resolves the path of an include directive. Quoted includes are first looked up
+// relative to the including file, and then in the include directories, angled includes
+// are only looked up in the include directories.
+//
+// If nothing is found, an empty string is returned, as the include is assumed to be
+// a system or third party header.
+func resolve(path string, system bool, absPath string, includeDirs []string) string {
+	if !system {
+		candidate := filepath.Join(filepath.Dir(absPath), path)
+		if utils.FileExists(candidate) {
+			return candidate
+		}
+	}
+	for _, dir := range includeDirs {
+		candidate := filepath.Join(dir, path)
+		if utils.FileExists(candidate) {
+			return candidate
+		}
+	}
+	return ""
+}
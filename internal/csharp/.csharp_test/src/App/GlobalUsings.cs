@@ -0,0 +1 @@
+global using Core.Services;
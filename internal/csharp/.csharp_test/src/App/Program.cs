@@ -0,0 +1,17 @@
+using Core.Models;
+using static Core.Util.Strings;
+
+namespace App;
+
+public class Program
+{
+    public static void Main(string[] args)
+    {
+        var user = new User();
+        var settings = new Settings();
+        var service = new UserService();
+        var thing = new Thing();
+        Console.WriteLine(Upper("x"));
+        Secret secret = null;
+    }
+}
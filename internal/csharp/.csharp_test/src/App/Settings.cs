@@ -0,0 +1,5 @@
+namespace App;
+
+internal class Settings
+{
+}
@@ -0,0 +1,6 @@
+namespace Core.Models;
+
+public partial class User
+{
+    public bool IsValid() => Name != null;
+}
@@ -0,0 +1,11 @@
+namespace Core.Models
+{
+    public partial class User
+    {
+        public string Name { get; set; }
+    }
+
+    internal class Secret
+    {
+    }
+}
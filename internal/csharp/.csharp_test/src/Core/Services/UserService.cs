@@ -0,0 +1,11 @@
+using Core.Models;
+using Infra;
+
+namespace Core.Services;
+
+public class UserService
+{
+    private readonly Logger _logger = new Logger();
+
+    public User Find() => new User();
+}
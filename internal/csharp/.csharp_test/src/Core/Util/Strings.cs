@@ -0,0 +1,6 @@
+namespace Core.Util;
+
+public static class Strings
+{
+    public static string Upper(string s) => s.ToUpper();
+}
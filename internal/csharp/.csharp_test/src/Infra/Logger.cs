@@ -0,0 +1,5 @@
+namespace Infra;
+
+public class Logger
+{
+}
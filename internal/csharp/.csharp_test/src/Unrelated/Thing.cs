@@ -0,0 +1,5 @@
+namespace Core.Models;
+
+public class Thing
+{
+}
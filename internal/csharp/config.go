@@ -0,0 +1,3 @@
+package csharp
+
+type Config struct{}
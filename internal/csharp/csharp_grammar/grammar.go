@@ -0,0 +1,176 @@
+//nolint:govet
+package csharp_grammar
+
+import (
+	"bytes"
+	"os"
+	"unicode"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+type Statement struct {
+	Using     *Using     `  @@`
+	Namespace *Namespace `| @@`
+	Type      *Type      `| @@`
+	// Block swallows anything between braces that is not a namespace, so that
+	// only top level declarations are matched as statements.
+	Block *Block `| @@`
+}
+
+type Block struct {
+	Blocks []*Block `"{" (@@ | ANY | Punct | Ident | String)* "}"`
+}
+
+type File struct {
+	Statements []*Statement `(@@ | ANY | Punct | Ident | String | Brace)*`
+	// Idents are the capitalized identifiers referenced in the file, in order of
+	// appearance and without duplicates. C# resolves types through the namespaces
+	// in scope without naming the file, so these are used for matching them
+	// against the types declared in other files.
+	Idents []string
+}
+
+var (
+	lex = lexer.MustSimple(
+		[]lexer.SimpleRule{
+			{"Brace", `[{}]`},
+			{"Punct", `[.;,=()<>\[\]:]`},
+			{"String", `"""(.|\n)*?"""` + "|" + `[$@]*"(?:\\.|""|[^"\\])*"` + "|" + `'(?:\\.|[^'\\])*'`},
+			{"Ident", `@?[_a-zA-Z][_a-zA-Z0-9]*`},
+			{"Comment", `//.*|/\*(.|\n)*?\*/`},
+			{"Whitespace", `\s+`},
+			{"ANY", `.`},
+		},
+	)
+	parser = participle.MustBuild[File](
+		participle.Lexer(lex),
+		participle.Elide("Whitespace", "Comment"),
+		participle.UseLookahead(1024),
+	)
+)
+
+// Namespaces returns all the namespaces declared in the file, flattening
+// nested namespace blocks into their fully qualified form.
+func (f *File) Namespaces() []*Namespace {
+	return flattenNamespaces(f.Statements, nil)
+}
+
+func flattenNamespaces(statements []*Statement, parent []string) []*Namespace {
+	var result []*Namespace
+	for _, stmt := range statements {
+		if stmt.Namespace == nil {
+			continue
+		}
+		name := append(append([]string{}, parent...), stmt.Namespace.Name...)
+		result = append(result, &Namespace{
+			Name:       name,
+			FileScoped: stmt.Namespace.FileScoped,
+			Statements: stmt.Namespace.Statements,
+		})
+		result = append(result, flattenNamespaces(stmt.Namespace.Statements, name)...)
+	}
+	return result
+}
+
+// DeclaredType is a top level type together with the namespace where it's declared.
+type DeclaredType struct {
+	*Type
+	Namespace string
+}
+
+// Types returns the top level types declared in the file, no matter if they are
+// declared inside a namespace block or not.
+func (f *File) Types() []DeclaredType {
+	var result []DeclaredType
+	namespace := ""
+	for _, stmt := range f.Statements {
+		switch {
+		case stmt.Namespace != nil && stmt.Namespace.FileScoped:
+			namespace = stmt.Namespace.String()
+		case stmt.Type != nil:
+			result = append(result, DeclaredType{Type: stmt.Type, Namespace: namespace})
+		}
+	}
+	for _, ns := range f.Namespaces() {
+		for _, stmt := range ns.Statements {
+			if stmt.Type != nil {
+				result = append(result, DeclaredType{Type: stmt.Type, Namespace: ns.String()})
+			}
+		}
+	}
+	return result
+}
+
+// Usings returns all the using directives in the file, including the ones
+// declared inside namespace blocks.
+func (f *File) Usings() []*Using {
+	var result []*Using
+	for _, stmt := range f.Statements {
+		if stmt.Using != nil {
+			result = append(result, stmt.Using)
+		}
+	}
+	for _, namespace := range f.Namespaces() {
+		for _, stmt := range namespace.Statements {
+			if stmt.Using != nil {
+				result = append(result, stmt.Using)
+			}
+		}
+	}
+	return result
+}
+
+func collectIdents(filePath string, content []byte) ([]string, error) {
+	l, err := lex.Lex(filePath, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := lexer.ConsumeAll(l)
+	if err != nil {
+		return nil, err
+	}
+	identType := lex.Symbols()["Ident"]
+	seen := map[string]struct{}{}
+	var idents []string
+	for _, token := range tokens {
+		if token.Type != identType {
+			continue
+		}
+		value := token.Value
+		if value[0] == '@' {
+			value = value[1:]
+		}
+		if !unicode.IsUpper(rune(value[0])) {
+			continue
+		}
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			idents = append(idents, value)
+		}
+	}
+	return idents, nil
+}
+
+func Parse(filePath string) (*language.FileInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseBytes(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	file.Idents, err = collectIdents(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	return &language.FileInfo{
+		Content: file,
+		Loc:     bytes.Count(content, []byte("\n")),
+		Size:    len(content),
+		AbsPath: filePath,
+	}, nil
+}
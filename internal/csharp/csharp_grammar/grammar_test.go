@@ -0,0 +1,99 @@
+package csharp_grammar
+
+import (
+	"testing"
+
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		Name               string
+		ExpectedNamespaces []string
+		ExpectedUsings     []Using
+		ExpectedTypes      []string
+		ExpectedIdents     []string
+	}{
+		{
+			Name:           "using System.Text;",
+			ExpectedUsings: []Using{{Path: []string{"System", "Text"}}},
+			ExpectedIdents: []string{"System", "Text"},
+		},
+		{
+			Name: "global using static Foo.Bar; using Baz = Foo.Qux;",
+			ExpectedUsings: []Using{
+				{Global: true, Static: true, Path: []string{"Foo", "Bar"}},
+				{Alias: "Baz", Path: []string{"Foo", "Qux"}},
+			},
+			ExpectedIdents: []string{"Foo", "Bar", "Baz", "Qux"},
+		},
+		{
+			Name:               "namespace App.Models;\n\npublic class User {}\ninternal record Role(string Name);",
+			ExpectedNamespaces: []string{"App.Models"},
+			ExpectedTypes:      []string{"App.Models public class User", "App.Models record Role"},
+			ExpectedIdents:     []string{"App", "Models", "User", "Role", "Name"},
+		},
+		{
+			Name:               "namespace App {\n  using Lib;\n  namespace Inner {\n    public partial struct Foo { class Nested {} }\n  }\n  public interface IBar {}\n}",
+			ExpectedNamespaces: []string{"App", "App.Inner"},
+			ExpectedUsings:     []Using{{Path: []string{"Lib"}}},
+			ExpectedTypes:      []string{"App public interface IBar", "App.Inner public struct Foo"},
+			ExpectedIdents:     []string{"App", "Lib", "Inner", "Foo", "Nested", "IBar"},
+		},
+		{
+			Name:           "[Serializable]\npublic sealed class Foo<T> : Bar where T : new() { void F() { using (var x = new Baz()) {} var s = @\"class \"\"Q\"\"\"; } }",
+			ExpectedTypes:  []string{" public class Foo"},
+			ExpectedIdents: []string{"Serializable", "Foo", "T", "Bar", "F", "Baz"},
+		},
+		{
+			Name:           "public record struct Point(int X, int Y);\npublic enum Color { Red }",
+			ExpectedTypes:  []string{" public record Point", " public enum Color"},
+			ExpectedIdents: []string{"Point", "X", "Y", "Color", "Red"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			parsed, err := parser.ParseBytes("", []byte(tt.Name))
+			a.NoError(err)
+			idents, err := collectIdents("", []byte(tt.Name))
+			a.NoError(err)
+
+			var namespaces []string
+			for _, ns := range parsed.Namespaces() {
+				namespaces = append(namespaces, ns.String())
+			}
+			var usings []Using
+			for _, using := range parsed.Usings() {
+				used := *using
+				used.Pos = lexer.Position{}
+				usings = append(usings, used)
+			}
+			var types []string
+			for _, declared := range parsed.Types() {
+				name := declared.Kind + " " + declared.Name
+				if declared.Public() {
+					name = "public " + name
+				}
+				types = append(types, declared.Namespace+" "+name)
+			}
+			a.Equal(tt.ExpectedNamespaces, namespaces)
+			a.Equal(tt.ExpectedUsings, usings)
+			a.Equal(tt.ExpectedTypes, types)
+			a.Equal(tt.ExpectedIdents, idents)
+		})
+	}
+}
+
+func TestType_Abstract(t *testing.T) {
+	a := require.New(t)
+	parsed, err := parser.ParseBytes("", []byte("namespace N;\npublic interface IA {}\npublic abstract class B {}\nsealed class C {}\nstruct D {}"))
+	a.NoError(err)
+	var abstract []bool
+	for _, declared := range parsed.Types() {
+		abstract = append(abstract, declared.Abstract())
+	}
+	a.Equal([]bool{true, true, false, false}, abstract)
+}
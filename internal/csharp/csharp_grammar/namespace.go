@@ -0,0 +1,16 @@
+//nolint:govet
+package csharp_grammar
+
+import "strings"
+
+type Namespace struct {
+	Name []string `"namespace" @Ident ("." @Ident)*`
+	// FileScoped namespaces (`namespace Foo;`) apply to the rest of the file.
+	FileScoped bool `(@";"`
+	// Statements are the statements inside a namespace block.
+	Statements []*Statement `| "{" (@@ | ANY | Punct | Ident | String)* "}")`
+}
+
+func (n *Namespace) String() string {
+	return strings.Join(n.Name, ".")
+}
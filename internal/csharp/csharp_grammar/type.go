@@ -0,0 +1,30 @@
+//nolint:govet
+package csharp_grammar
+
+type Type struct {
+	Modifiers []string `@("public" | "internal" | "protected" | "private" | "static" | "sealed" | "abstract" | "partial" | "readonly" | "unsafe" | "new" | "file" | "ref")*`
+	Kind      string   `@("class" | "struct" | "interface" | "enum" | "record") ("class" | "struct")?`
+	Name      string   `@Ident`
+}
+
+// Abstract tells if the type cannot be instantiated, like interfaces or abstract classes.
+func (t *Type) Abstract() bool {
+	if t.Kind == "interface" {
+		return true
+	}
+	for _, modifier := range t.Modifiers {
+		if modifier == "abstract" {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Type) Public() bool {
+	for _, modifier := range t.Modifiers {
+		if modifier == "public" {
+			return true
+		}
+	}
+	return false
+}
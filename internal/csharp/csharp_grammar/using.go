@@ -0,0 +1,12 @@
+//nolint:govet
+package csharp_grammar
+
+import "github.com/alecthomas/participle/v2/lexer"
+
+type Using struct {
+	Pos    lexer.Position
+	Global bool     `@"global"? "using"`
+	Static bool     `@"static"?`
+	Alias  string   `(@Ident "=")?`
+	Path   []string `@Ident ("." @Ident)* ";"`
+}
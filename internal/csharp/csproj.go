@@ -0,0 +1,189 @@
+package csharp
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/csharp/csharp_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+const csprojExt = ".csproj"
+
+type declaredType struct {
+	AbsPath string
+	Public  bool
+}
+
+// Project is a .csproj project together with an index of the types declared
+// by the source files that belong to it.
+type Project struct {
+	// Name is the name of the .csproj file without the extension.
+	Name string
+	// AbsDir is the directory where the .csproj file is located.
+	AbsDir string
+	// References are the absolute paths of the directly referenced .csproj files.
+	References []string
+	// Namespaces maps each namespace to the types declared in it, and each type
+	// to the files where it's declared. Partial types can be declared in several files.
+	Namespaces map[string]map[string][]declaredType
+	// GlobalUsings are the namespaces imported with `global using` in any
+	// file of the project, which apply to every other file.
+	GlobalUsings [][]string
+}
+
+type csprojXml struct {
+	ItemGroups []struct {
+		ProjectReferences []struct {
+			Include string `xml:"Include,attr"`
+		} `xml:"ProjectReference"`
+	} `xml:"ItemGroup"`
+}
+
+const slnExt = ".sln"
+
+// findClosestFileWithExt starts from a search path and goes up dir by dir until
+// a file with the provided extension is found. If none is found, it returns an
+// empty string.
+func findClosestFileWithExt(searchPath string, ext string) string {
+	entries, _ := os.ReadDir(searchPath)
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ext) {
+			return filepath.Join(searchPath, entry.Name())
+		}
+	}
+	nextSearchPath := filepath.Dir(searchPath)
+	if nextSearchPath != searchPath {
+		return findClosestFileWithExt(nextSearchPath, ext)
+	} else {
+		return ""
+	}
+}
+
+var findClosestCsproj = utils.Cached1In1Out(func(searchPath string) string {
+	return findClosestFileWithExt(searchPath, csprojExt)
+})
+
+var findClosestSln = utils.Cached1In1Out(func(searchPath string) string {
+	return findClosestFileWithExt(searchPath, slnExt)
+})
+
+// sourceFiles returns the .cs files that belong to a project rooted at dir, which, like
+// SDK style projects do by default, are all the files in the directory tree except the
+// ones in build output folders or in the folders of other nested projects.
+func sourceFiles(dir string, root bool) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	if !root {
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), csprojExt) {
+				return nil
+			}
+		}
+	}
+	var result []string
+	for _, entry := range entries {
+		switch {
+		case entry.IsDir() && (entry.Name() == "bin" || entry.Name() == "obj" || strings.HasPrefix(entry.Name(), ".")):
+			continue
+		case entry.IsDir():
+			result = append(result, sourceFiles(filepath.Join(dir, entry.Name()), false)...)
+		case utils.EndsWith(entry.Name(), Extensions):
+			result = append(result, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return result
+}
+
+func (p *Project) index(files []string) {
+	for _, file := range files {
+		parsed, err := parseCsharpFile(file)
+		if err != nil {
+			// A broken file in the project should not prevent resolving the rest.
+			continue
+		}
+		content := parsed.Content.(*csharp_grammar.File)
+		for _, declared := range content.Types() {
+			types, ok := p.Namespaces[declared.Namespace]
+			if !ok {
+				types = map[string][]declaredType{}
+				p.Namespaces[declared.Namespace] = types
+			}
+			types[declared.Name] = append(types[declared.Name], declaredType{
+				AbsPath: file,
+				Public:  declared.Public(),
+			})
+		}
+		for _, using := range content.Usings() {
+			if using.Global && !using.Static && using.Alias == "" {
+				p.GlobalUsings = append(p.GlobalUsings, using.Path)
+			}
+		}
+	}
+}
+
+// _readProject reads and indexes a project given the path to its .csproj file. If the
+// path is a directory instead, it indexes the directory as if it was a project with no
+// references, which is useful for loose files that do not belong to any .csproj.
+func _readProject(path string) (*Project, error) {
+	project := Project{Namespaces: map[string]map[string][]declaredType{}}
+	if !strings.HasSuffix(path, csprojExt) {
+		project.AbsDir = path
+		project.index(sourceFiles(path, true))
+		return &project, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var decoded csprojXml
+	err = xml.Unmarshal(content, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %q: %w", path, err)
+	}
+	project.Name = strings.TrimSuffix(filepath.Base(path), csprojExt)
+	project.AbsDir = filepath.Dir(path)
+	for _, itemGroup := range decoded.ItemGroups {
+		for _, reference := range itemGroup.ProjectReferences {
+			// .csproj files are typically written in Windows.
+			include := strings.ReplaceAll(reference.Include, "\\", "/")
+			project.References = append(project.References, filepath.Join(project.AbsDir, filepath.FromSlash(include)))
+		}
+	}
+	project.index(sourceFiles(project.AbsDir, true))
+	return &project, nil
+}
+
+var readProject = utils.Cached1In1OutErr(_readProject)
+
+// visibleProjects returns the project itself followed by all the projects that it
+// references, either directly or transitively.
+func visibleProjects(project *Project) ([]*Project, []error) {
+	result := []*Project{project}
+	var errors []error
+	seen := map[string]struct{}{project.AbsDir: {}}
+	for i := 0; i < len(result); i++ {
+		references := append([]string{}, result[i].References...)
+		sort.Strings(references)
+		for _, reference := range references {
+			if _, ok := seen[filepath.Dir(reference)]; ok {
+				continue
+			}
+			seen[filepath.Dir(reference)] = struct{}{}
+			referenced, err := readProject(reference)
+			if err != nil {
+				errors = append(errors, err)
+				continue
+			}
+			result = append(result, referenced)
+		}
+	}
+	return result, errors
+}
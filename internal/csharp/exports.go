@@ -0,0 +1,24 @@
+package csharp
+
+import (
+	"github.com/gabotechs/dep-tree/internal/csharp/csharp_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	exports := make([]language.ExportEntry, 0)
+
+	content := file.Content.(*csharp_grammar.File)
+	for _, declared := range content.Types() {
+		if declared.Public() {
+			exports = append(exports, language.ExportEntry{
+				Symbols: []language.ExportSymbol{{Original: declared.Name}},
+				AbsPath: file.AbsPath,
+			})
+		}
+	}
+
+	return &language.ExportsResult{
+		Exports: exports,
+	}, nil
+}
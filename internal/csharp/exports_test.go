@@ -0,0 +1,48 @@
+package csharp
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func TestLanguage_ParseExports(t *testing.T) {
+	srcFolder, _ := filepath.Abs(filepath.Join(testFolder, "src"))
+
+	tests := []struct {
+		Name     string
+		Expected []language.ExportEntry
+	}{
+		{
+			Name: filepath.Join("Core", "Models", "User.cs"),
+			Expected: []language.ExportEntry{
+				{
+					Symbols: []language.ExportSymbol{{Original: "User"}},
+					AbsPath: filepath.Join(srcFolder, "Core", "Models", "User.cs"),
+				},
+			},
+		},
+		{
+			Name:     filepath.Join("App", "Settings.cs"),
+			Expected: []language.ExportEntry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeCsharpLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(filepath.Join(srcFolder, tt.Name))
+			a.NoError(err)
+
+			exports, err := lang.ParseExports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, exports.Exports)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package csharp
+
+import (
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/csharp/csharp_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+// enclosingNamespaces returns the namespaces whose types are in scope for code declared
+// in a namespace, e.g. code in `A.B.C` sees the types in `A.B.C`, `A.B`, `A` and the
+// global namespace.
+func enclosingNamespaces(namespace string) []string {
+	var result []string
+	for namespace != "" {
+		result = append(result, namespace)
+		if i := strings.LastIndex(namespace, "."); i >= 0 {
+			namespace = namespace[:i]
+		} else {
+			namespace = ""
+		}
+	}
+	return append(result, "")
+}
+
+type resolver struct {
+	project  *Project
+	projects []*Project
+	absPath  string
+	imports  []language.ImportEntry
+}
+
+// resolve looks up a type name in a namespace across the visible projects, and imports
+// the files where it's declared. Types that are not public are only visible from the same
+// project. It returns true if the type was found.
+func (r *resolver) resolve(namespace string, name string) bool {
+	for _, project := range r.projects {
+		declarations, ok := project.Namespaces[namespace][name]
+		if !ok {
+			continue
+		}
+		for _, declared := range declarations {
+			if declared.AbsPath == r.absPath {
+				// The type is declared in the file itself, and if it's partial, the other
+				// files that declare it are parts of the same type, not dependencies.
+				return true
+			}
+		}
+		found := false
+		for _, declared := range declarations {
+			switch {
+			case !declared.Public && project != r.project:
+				continue
+			case declared.Public:
+				r.imports = append(r.imports, language.SymbolsImport([]string{name}, declared.AbsPath))
+			default:
+				// Internal types are not exported, but they are still visible from the same project.
+				r.imports = append(r.imports, language.EmptyImport(declared.AbsPath))
+			}
+			found = true
+		}
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	content := file.Content.(*csharp_grammar.File)
+
+	project, err := projectForFile(file.AbsPath)
+	if err != nil {
+		return &language.ImportsResult{Imports: make([]language.ImportEntry, 0), Errors: []error{err}}, nil
+	}
+	projects, errors := visibleProjects(project)
+	r := resolver{
+		project:  project,
+		projects: projects,
+		absPath:  file.AbsPath,
+		imports:  make([]language.ImportEntry, 0),
+	}
+
+	// 1. Gather the namespaces in scope: the ones enclosing the namespaces declared in the
+	//    file, and the ones imported by using directives, either in this file or globally
+	//    in the project. Static and alias usings point to specific types, so they are
+	//    resolved right away.
+	var scope []string
+	seen := map[string]struct{}{}
+	addToScope := func(namespace string) {
+		if _, ok := seen[namespace]; !ok {
+			seen[namespace] = struct{}{}
+			scope = append(scope, namespace)
+		}
+	}
+	for _, namespace := range content.Namespaces() {
+		for _, enclosing := range enclosingNamespaces(namespace.String()) {
+			addToScope(enclosing)
+		}
+	}
+	addToScope("")
+
+	aliased := map[string]struct{}{}
+	for _, using := range content.Usings() {
+		path := strings.Join(using.Path, ".")
+		switch {
+		case using.Static || using.Alias != "":
+			namespace := strings.Join(using.Path[:len(using.Path)-1], ".")
+			start := len(r.imports)
+			r.resolve(namespace, using.Path[len(using.Path)-1])
+			for i := start; i < len(r.imports); i++ {
+				r.imports[i].Line, r.imports[i].Column = using.Pos.Line, using.Pos.Column
+			}
+			if using.Alias != "" {
+				aliased[using.Alias] = struct{}{}
+			}
+		default:
+			addToScope(path)
+		}
+	}
+	for _, using := range project.GlobalUsings {
+		addToScope(strings.Join(using, "."))
+	}
+
+	// 2. Match the identifiers used in the file against the types declared in the
+	//    namespaces in scope.
+	for _, ident := range content.Idents {
+		if _, ok := aliased[ident]; ok {
+			continue
+		}
+		for _, namespace := range scope {
+			if r.resolve(namespace, ident) {
+				break
+			}
+		}
+	}
+
+	return &language.ImportsResult{
+		Imports: r.imports,
+		Errors:  errors,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package csharp
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".csharp_test"
+
+func TestLanguage_ParseImports(t *testing.T) {
+	srcFolder, _ := filepath.Abs(filepath.Join(testFolder, "src"))
+
+	tests := []struct {
+		Name     string
+		File     string
+		Expected []language.ImportEntry
+	}{
+		{
+			Name: "Program.cs",
+			File: filepath.Join("App", "Program.cs"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"Strings"}, filepath.Join(srcFolder, "Core", "Util", "Strings.cs")), 2, 1),
+				language.SymbolsImport([]string{"User"}, filepath.Join(srcFolder, "Core", "Models", "User.Validation.cs")),
+				language.SymbolsImport([]string{"User"}, filepath.Join(srcFolder, "Core", "Models", "User.cs")),
+				language.EmptyImport(filepath.Join(srcFolder, "App", "Settings.cs")),
+				language.SymbolsImport([]string{"UserService"}, filepath.Join(srcFolder, "Core", "Services", "UserService.cs")),
+			},
+		},
+		{
+			Name: "UserService.cs",
+			File: filepath.Join("Core", "Services", "UserService.cs"),
+			Expected: []language.ImportEntry{
+				language.SymbolsImport([]string{"Logger"}, filepath.Join(srcFolder, "Infra", "Logger.cs")),
+				language.SymbolsImport([]string{"User"}, filepath.Join(srcFolder, "Core", "Models", "User.Validation.cs")),
+				language.SymbolsImport([]string{"User"}, filepath.Join(srcFolder, "Core", "Models", "User.cs")),
+			},
+		},
+		{
+			Name:     "User.cs",
+			File:     filepath.Join("Core", "Models", "User.cs"),
+			Expected: []language.ImportEntry{},
+		},
+		{
+			Name:     "User.Validation.cs",
+			File:     filepath.Join("Core", "Models", "User.Validation.cs"),
+			Expected: []language.ImportEntry{},
+		},
+		{
+			Name:     "Logger.cs",
+			File:     filepath.Join("Infra", "Logger.cs"),
+			Expected: []language.ImportEntry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeCsharpLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(filepath.Join(srcFolder, tt.File))
+			a.NoError(err)
+
+			imports, err := lang.ParseImports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, imports.Imports)
+			a.Nil(imports.Errors)
+		})
+	}
+}
+
+func at(entry language.ImportEntry, line, column int) language.ImportEntry {
+	entry.Line, entry.Column = line, column
+	return entry
+}
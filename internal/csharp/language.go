@@ -0,0 +1,83 @@
+package csharp
+
+import (
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/csharp/csharp_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var Extensions = []string{
+	"cs",
+}
+
+type Language struct {
+	cfg *Config
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+
+func MakeCsharpLanguage(cfg *Config) (language.Language, error) {
+	lang := Language{
+		cfg: cfg,
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+	return &lang, nil
+}
+
+var parseCsharpFile, evictCsharpFile = utils.EvictableCached1In1OutErr(csharp_grammar.Parse)
+
+// projectForFile returns the project to which a file belongs.
+func projectForFile(absPath string) (*Project, error) {
+	csproj := findClosestCsproj(filepath.Dir(absPath))
+	if csproj == "" {
+		return readProject(filepath.Dir(absPath))
+	}
+	return readProject(csproj)
+}
+
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	parsed, err := parseCsharpFile(id)
+	if err != nil {
+		return nil, err
+	}
+	file := *parsed
+	file.Types, file.AbstractTypes = countTypes(file.Content.(*csharp_grammar.File))
+	csproj := findClosestCsproj(filepath.Dir(id))
+	if csproj == "" {
+		return &file, nil
+	}
+	project, err := readProject(csproj)
+	if err != nil {
+		return &file, nil
+	}
+	file.Package = project.Name
+	if sln := findClosestSln(filepath.Dir(csproj)); sln != "" {
+		file.RelPath, _ = filepath.Rel(filepath.Dir(sln), id)
+	} else {
+		file.RelPath, _ = filepath.Rel(project.AbsDir, id)
+	}
+	return &file, nil
+}
+
+// Invalidate drops the provided files.
+func (l *Language) Invalidate(absPaths ...string) {
+	evictCsharpFile(utils.IsAnyOf(absPaths))
+}
+
+// countTypes returns how many types are declared in the file, and how many of them are
+// interfaces or abstract classes.
+func countTypes(file *csharp_grammar.File) (int, int) {
+	types, abstractTypes := 0, 0
+	for _, declared := range file.Types() {
+		types++
+		if declared.Abstract() {
+			abstractTypes++
+		}
+	}
+	return types, abstractTypes
+}
@@ -0,0 +1,51 @@
+package csharp
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguage_ParseFile(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Path            string
+		ExpectedRelPath string
+		ExpectedPackage string
+		ExpectedTypes   int
+	}{
+		{
+			Name:            "App project",
+			Path:            filepath.Join(testFolder, "src", "App", "Program.cs"),
+			ExpectedRelPath: "src/App/Program.cs",
+			ExpectedPackage: "App",
+			ExpectedTypes:   1,
+		},
+		{
+			Name:            "Core project",
+			Path:            filepath.Join(testFolder, "src", "Core", "Models", "User.cs"),
+			ExpectedRelPath: "src/Core/Models/User.cs",
+			ExpectedPackage: "Core",
+			ExpectedTypes:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeCsharpLanguage(nil)
+			a.NoError(err)
+			absPath, _ := filepath.Abs(tt.Path)
+			file, err := lang.ParseFile(absPath)
+			a.NoError(err)
+			a.Equal(tt.ExpectedPackage, file.Package)
+			a.Equal(tt.ExpectedRelPath, file.RelPath)
+			a.Equal(tt.ExpectedTypes, file.Types)
+			// parsing the same file again must not count its types twice.
+			file, err = lang.ParseFile(absPath)
+			a.NoError(err)
+			a.Equal(tt.ExpectedTypes, file.Types)
+		})
+	}
+}
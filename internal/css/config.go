@@ -0,0 +1,7 @@
+package css
+
+type Config struct {
+	// LoadPaths are the dirs against which imports that are not relative to the
+	// importing file are resolved, like Sass's --load-path option.
+	LoadPaths []string `yaml:"loadPaths"`
+}
@@ -0,0 +1,142 @@
+//nolint:govet
+package css_grammar
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+type Statement struct {
+	Pos     lexer.Position
+	Import  *Import  `  @@`
+	Use     *Use     `| @@`
+	Forward *Forward `| @@`
+	// Mixin, Variable and Placeholder are members that can be used from other
+	// stylesheets.
+	Mixin       *Mixin       `| @@`
+	Variable    *Variable    `| @@`
+	Placeholder *Placeholder `| @@`
+	// Block holds the statements nested in a rule. Members declared inside it
+	// are local, but imports are still valid there.
+	Block *Block `| @@`
+	// Parens swallows arguments, like the ones of mixins, so that they are not
+	// mistaken by declarations.
+	Parens *Parens `| @@`
+}
+
+type Parens struct {
+	Parens []*Parens `"(" (@@ | ANY | Punct | Ident | String | Url | AtKeyword | Variable | Brace)* ")"`
+}
+
+type Block struct {
+	Statements []*Statement `"{" (@@ | ANY | Punct | Paren | Ident | String | Url | AtKeyword | Variable | SassImport | SassComma | BarePath)* "}"`
+}
+
+type File struct {
+	Statements []*Statement `(@@ | ANY | Punct | Paren | Ident | String | Url | AtKeyword | Variable | Brace | SassImport | SassComma | BarePath)*`
+}
+
+const (
+	urlPattern     = `url\(\s*(?:"(?:\\.|[^"\\])*"|'(?:\\.|[^'\\])*'|[^)]*)\s*\)`
+	stringPattern  = `"(?:\\.|[^"\\])*"` + "|" + `'(?:\\.|[^'\\])*'`
+	commentPattern = `/\*(.|\n)*?\*/|//.*`
+
+	sassImportPattern = `@import\b`
+)
+
+// rules are the lexer rules for stylesheets. Indented Sass allows unquoted @import
+// paths, so for it an @import switches to a state where the rest of the line is
+// lexed as paths.
+func rules(sass bool) lexer.Rules {
+	root := []lexer.Rule{
+		{"Url", urlPattern, nil},
+		{"String", stringPattern, nil},
+		{"Comment", commentPattern, nil},
+		{"AtKeyword", `@[-_a-zA-Z][-_a-zA-Z0-9]*`, nil},
+		{"Variable", `\$[-_a-zA-Z][-_a-zA-Z0-9]*`, nil},
+		{"Ident", `-?[_a-zA-Z][-_a-zA-Z0-9]*`, nil},
+		{"Brace", `[{}]`, nil},
+		{"Paren", `[()]`, nil},
+		{"Punct", `[.,;:%#>+~*=!\[\]]`, nil},
+		{"Whitespace", `\s+`, nil},
+		{"ANY", `.`, nil},
+	}
+	if sass {
+		root = append([]lexer.Rule{{"SassImport", sassImportPattern, lexer.Push("SassImport")}}, root...)
+	}
+	return lexer.Rules{
+		"Root": root,
+		// SassImport is declared in both lexers, so that the grammar is the same for
+		// both of them, but it's only reachable from an indented Sass @import.
+		"SassImport": {
+			{"SassImport", sassImportPattern, nil},
+			{"SassEnd", `\r?\n|;`, lexer.Pop()},
+			{"Url", urlPattern, nil},
+			{"String", stringPattern, nil},
+			{"Comment", commentPattern, nil},
+			{"SassComma", `,`, nil},
+			{"SassSpace", `[ \t]+`, nil},
+			{"BarePath", `[^\s,;"'()]+`, nil},
+		},
+	}
+}
+
+func build(sass bool) *participle.Parser[File] {
+	return participle.MustBuild[File](
+		participle.Lexer(lexer.MustStateful(rules(sass))),
+		participle.Elide("Whitespace", "Comment", "SassEnd", "SassSpace"),
+		utils.UnquoteSafe("String"),
+		participle.UseLookahead(1024),
+	)
+}
+
+var (
+	parser     = build(false)
+	sassParser = build(true)
+)
+
+// Imports returns the import, use and forward statements of the file, including
+// the ones nested in rules.
+func (f *File) Imports() []*Statement {
+	return collectImports(f.Statements)
+}
+
+func collectImports(statements []*Statement) []*Statement {
+	var result []*Statement
+	for _, stmt := range statements {
+		switch {
+		case stmt.Import != nil, stmt.Use != nil, stmt.Forward != nil:
+			result = append(result, stmt)
+		case stmt.Block != nil:
+			result = append(result, collectImports(stmt.Block.Statements)...)
+		}
+	}
+	return result
+}
+
+func Parse(filePath string) (*language.FileInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	p := parser
+	if filepath.Ext(filePath) == ".sass" {
+		p = sassParser
+	}
+	file, err := p.ParseBytes(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	return &language.FileInfo{
+		Content: file,
+		Loc:     bytes.Count(content, []byte("\n")),
+		Size:    len(content),
+		AbsPath: filePath,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package css_grammar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Sass            bool
+		ExpectedImports []string
+		ExpectedMembers []string
+	}{
+		{
+			Name:            `@import "a", 'b/c';`,
+			ExpectedImports: []string{"import a", "import b/c"},
+		},
+		{
+			Name:            `@import url("https://fonts.com/css?x=1") screen; @import url(d.css);`,
+			ExpectedImports: []string{"import https://fonts.com/css?x=1", "import d.css"},
+		},
+		{
+			Name:            `@import (reference, optional) "mixins"; @base: #fff;`,
+			ExpectedImports: []string{"import mixins"},
+			ExpectedMembers: []string{"@base"},
+		},
+		{
+			Name:            "@use \"sass:math\";\n@use 'tokens' as t with ($a: 1);\n@forward \"button\" show b;",
+			ExpectedImports: []string{"use sass:math", "use tokens", "forward button"},
+		},
+		{
+			Name:            "$primary: blue;\n@mixin focus-ring($w: 1px) { outline: $w; }\n@function rem($px) { @return $px; }\n%hidden { $local: 1; }",
+			ExpectedMembers: []string{"$primary", "focus-ring", "rem", "hidden"},
+		},
+		{
+			Name:            ".a { width: 50%; .b { @import 'nested'; } }\n// @import 'commented';\n/* @use 'also'; */\n.c { background: url(//cdn.com/x.png) }",
+			ExpectedImports: []string{"import nested"},
+		},
+		{
+			Name:            "@import base, 'quoted'\n@import partials/buttons.sass; @import url(d.css)\n.a\n  color: $primary",
+			Sass:            true,
+			ExpectedImports: []string{"import base", "import quoted", "import partials/buttons.sass", "import d.css"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			p := parser
+			if tt.Sass {
+				p = sassParser
+			}
+			parsed, err := p.ParseBytes("", []byte(tt.Name))
+			a.NoError(err)
+
+			var imports []string
+			for _, stmt := range parsed.Imports() {
+				switch {
+				case stmt.Import != nil:
+					for _, target := range stmt.Import.Targets {
+						imports = append(imports, "import "+target.Path())
+					}
+				case stmt.Use != nil:
+					imports = append(imports, "use "+stmt.Use.Path)
+				case stmt.Forward != nil:
+					imports = append(imports, "forward "+stmt.Forward.Path)
+				}
+			}
+			var members []string
+			for _, stmt := range parsed.Statements {
+				switch {
+				case stmt.Mixin != nil:
+					members = append(members, stmt.Mixin.Name)
+				case stmt.Variable != nil:
+					members = append(members, stmt.Variable.Name)
+				case stmt.Placeholder != nil:
+					members = append(members, stmt.Placeholder.Name)
+				}
+			}
+			a.Equal(tt.ExpectedImports, imports)
+			a.Equal(tt.ExpectedMembers, members)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+//nolint:govet
+package css_grammar
+
+import "strings"
+
+type ImportTarget struct {
+	Url    string `  @Url`
+	String string `| @String`
+	// Bare is an unquoted path, only allowed in the indented Sass syntax.
+	Bare string `| @BarePath`
+}
+
+// Path returns the imported path, unwrapping url(...) targets.
+func (t *ImportTarget) Path() string {
+	if t.Bare != "" {
+		return t.Bare
+	}
+	if t.Url == "" {
+		return t.String
+	}
+	inner := strings.TrimSpace(t.Url[len("url(") : len(t.Url)-1])
+	if len(inner) > 1 && (inner[0] == '"' || inner[0] == '\'') && inner[len(inner)-1] == inner[0] {
+		return inner[1 : len(inner)-1]
+	}
+	return inner
+}
+
+// Import is a CSS, SCSS or Less @import, which might import several files at once,
+// like `@import "a", "b";`, and might have Less options, like `@import (reference) "a";`.
+type Import struct {
+	Targets []*ImportTarget `"@import" ("(" Ident ("," Ident)* ")")? @@ ("," @@)*`
+}
+
+// Use is a Sass module import, like `@use "a" as b;`.
+type Use struct {
+	Path string `"@use" @String`
+}
+
+// Forward is a Sass module re-export, like `@forward "a" show b;`.
+type Forward struct {
+	Path string `"@forward" @String`
+}
@@ -0,0 +1,19 @@
+//nolint:govet
+package css_grammar
+
+// Mixin is a Sass @mixin or @function declaration.
+type Mixin struct {
+	Kind string `@("@mixin" | "@function")`
+	Name string `@Ident`
+}
+
+// Variable is a Sass or Less variable declaration, like `$primary: blue;`
+// or `@primary: blue;`.
+type Variable struct {
+	Name string `@(Variable | AtKeyword) ":"`
+}
+
+// Placeholder is a Sass placeholder selector, like `%visually-hidden`.
+type Placeholder struct {
+	Name string `"%" @Ident`
+}
@@ -0,0 +1,41 @@
+package css
+
+import (
+	"github.com/gabotechs/dep-tree/internal/css/css_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	exports := make([]language.ExportEntry, 0)
+
+	var symbols []language.ExportSymbol
+	for _, stmt := range file.Content.(*css_grammar.File).Statements {
+		switch {
+		case stmt.Forward != nil:
+			// Forwarded modules expose all their members as if they were declared
+			// in the forwarding stylesheet.
+			if resolved := l.resolve(stmt.Forward.Path, file.AbsPath); resolved != "" {
+				exports = append(exports, language.ExportEntry{
+					All:     true,
+					AbsPath: resolved,
+				})
+			}
+		case stmt.Mixin != nil:
+			symbols = append(symbols, language.ExportSymbol{Original: stmt.Mixin.Name})
+		case stmt.Variable != nil:
+			symbols = append(symbols, language.ExportSymbol{Original: stmt.Variable.Name})
+		case stmt.Placeholder != nil:
+			symbols = append(symbols, language.ExportSymbol{Original: "%" + stmt.Placeholder.Name})
+		}
+	}
+	if len(symbols) > 0 {
+		exports = append(exports, language.ExportEntry{
+			Symbols: symbols,
+			AbsPath: file.AbsPath,
+		})
+	}
+
+	return &language.ExportsResult{
+		Exports: exports,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package css
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func TestLanguage_ParseExports(t *testing.T) {
+	styles, _ := filepath.Abs(filepath.Join(testFolder, "styles"))
+
+	tests := []struct {
+		Name     string
+		File     string
+		Expected []language.ExportEntry
+	}{
+		{
+			Name: "members",
+			File: filepath.Join(styles, "_tokens.scss"),
+			Expected: []language.ExportEntry{{
+				Symbols: []language.ExportSymbol{
+					{Original: "$primary"},
+					{Original: "$spacing"},
+					{Original: "focus-ring"},
+					{Original: "rem"},
+					{Original: "%visually-hidden"},
+				},
+				AbsPath: filepath.Join(styles, "_tokens.scss"),
+			}},
+		},
+		{
+			Name: "forward",
+			File: filepath.Join(styles, "components", "_index.scss"),
+			Expected: []language.ExportEntry{
+				{All: true, AbsPath: filepath.Join(styles, "components", "_button.scss")},
+				{All: true, AbsPath: filepath.Join(styles, "components", "_card.scss")},
+			},
+		},
+		{
+			Name:     "plain css",
+			File:     filepath.Join(styles, "vendor", "reset.css"),
+			Expected: []language.ExportEntry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeCssLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			exports, err := lang.ParseExports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, exports.Exports)
+		})
+	}
+}
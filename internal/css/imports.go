@@ -0,0 +1,43 @@
+package css
+
+import (
+	"fmt"
+
+	"github.com/gabotechs/dep-tree/internal/css/css_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	imports := make([]language.ImportEntry, 0)
+	var errors []error
+
+	add := func(path string, optional bool, stmt *css_grammar.Statement) {
+		if resolved := l.resolve(path, file.AbsPath); resolved != "" {
+			entry := language.EmptyImport(resolved)
+			entry.Line, entry.Column = stmt.Pos.Line, stmt.Pos.Column
+			imports = append(imports, entry)
+		} else if !optional && !external(path) {
+			errors = append(errors, fmt.Errorf("could not resolve stylesheet %q", path))
+		}
+	}
+
+	for _, stmt := range file.Content.(*css_grammar.File).Imports() {
+		switch {
+		case stmt.Import != nil:
+			for _, target := range stmt.Import.Targets {
+				// Plain CSS imports of urls might point to a server path that is
+				// not in the project, so they are not reported as errors.
+				add(target.Path(), target.Url != "", stmt)
+			}
+		case stmt.Use != nil:
+			add(stmt.Use.Path, false, stmt)
+		case stmt.Forward != nil:
+			add(stmt.Forward.Path, false, stmt)
+		}
+	}
+
+	return &language.ImportsResult{
+		Imports: imports,
+		Errors:  errors,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package css
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".css_test"
+
+func TestLanguage_ParseImports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+	styles := filepath.Join(absTestFolder, "styles")
+
+	tests := []struct {
+		Name           string
+		File           string
+		Expected       []language.ImportEntry
+		ExpectedErrors []string
+	}{
+		{
+			Name: "scss partials, load paths and node_modules",
+			File: filepath.Join(styles, "main.scss"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(styles, "_tokens.scss")), 2, 1),
+				at(language.EmptyImport(filepath.Join(styles, "components", "_button.scss")), 3, 1),
+				at(language.EmptyImport(filepath.Join(styles, "vendor", "reset.css")), 5, 1),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "shared", "_theme.scss")), 5, 1),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "node_modules", "bootstrap", "scss", "_grid.scss")), 6, 1),
+			},
+		},
+		{
+			Name: "index files",
+			File: filepath.Join(styles, "app.scss"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(styles, "components", "_index.scss")), 1, 1),
+			},
+			ExpectedErrors: []string{`could not resolve stylesheet "missing"`},
+		},
+		{
+			Name: "forward",
+			File: filepath.Join(styles, "components", "_index.scss"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(styles, "components", "_button.scss")), 1, 1),
+				at(language.EmptyImport(filepath.Join(styles, "components", "_card.scss")), 2, 1),
+			},
+		},
+		{
+			Name: "less",
+			File: filepath.Join(absTestFolder, "legacy", "site.less"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "legacy", "mixins.less")), 1, 1),
+				at(language.EmptyImport(filepath.Join(styles, "vendor", "reset.css")), 2, 1),
+			},
+		},
+		{
+			Name: "indented sass with unquoted paths",
+			File: filepath.Join(absTestFolder, "sass", "main.sass"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "sass", "_base.sass")), 1, 1),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "sass", "partials", "_buttons.sass")), 2, 1),
+				at(language.EmptyImport(filepath.Join(styles, "_tokens.scss")), 2, 1),
+			},
+		},
+		{
+			Name: "plain css",
+			File: filepath.Join(absTestFolder, "plain", "site.css"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "plain", "base.css")), 1, 1),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeCssLanguage(&Config{LoadPaths: []string{filepath.Join(testFolder, "shared")}})
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			imports, err := lang.ParseImports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, imports.Imports)
+			a.Equal(len(tt.ExpectedErrors), len(imports.Errors))
+			for i, err := range imports.Errors {
+				a.ErrorContains(err, tt.ExpectedErrors[i])
+			}
+		})
+	}
+}
+
+func at(entry language.ImportEntry, line, column int) language.ImportEntry {
+	entry.Line, entry.Column = line, column
+	return entry
+}
@@ -0,0 +1,63 @@
+package css
+
+import (
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/css/css_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var Extensions = []string{
+	"css",
+	"scss",
+	"sass",
+	"less",
+}
+
+type Language struct {
+	cfg *Config
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+
+func MakeCssLanguage(cfg *Config) (language.Language, error) {
+	lang := Language{
+		cfg: cfg,
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+	return &lang, nil
+}
+
+var findProjectRoot = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	"package.json",
+	".git/index",
+})
+
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	file, err := css_grammar.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	if projectRoot := findProjectRoot(filepath.Dir(id)); projectRoot != nil {
+		file.RelPath, _ = filepath.Rel(projectRoot.AbsDir, id)
+	}
+	return file, nil
+}
+
+// Invalidate drops the node_modules lookups of the dirs below a node_modules dir that
+// is among the provided files, as it might have been created or removed.
+func (l *Language) Invalidate(absPaths ...string) {
+	for _, absPath := range absPaths {
+		if filepath.Base(absPath) != "node_modules" {
+			continue
+		}
+		parent := filepath.Dir(absPath)
+		evictNodeModules(func(dir string) bool {
+			return utils.ContainsAnyOf([]string{dir})(parent)
+		})
+	}
+}
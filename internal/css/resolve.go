@@ -0,0 +1,110 @@
+package css
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// _findNodeModules returns the closest node_modules dir walking up from dir, or an
+// empty string if there is none.
+func _findNodeModules(dir string) string {
+	for {
+		if utils.DirExists(filepath.Join(dir, "node_modules")) {
+			return filepath.Join(dir, "node_modules")
+		}
+		next := filepath.Dir(dir)
+		if next == dir {
+			return ""
+		}
+		dir = next
+	}
+}
+
+var findNodeModules, evictNodeModules = utils.EvictableCached1In1Out(_findNodeModules)
+
+// external imports are the ones that do not reference a file in the project,
+// like Sass built-in modules or remote stylesheets.
+func external(path string) bool {
+	for _, prefix := range []string{"sass:", "http://", "https://", "//", "data:"} {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// extensions returns the stylesheet extensions to try when resolving an import,
+// preferring the one of the importing file.
+func extensions(absPath string) []string {
+	own := strings.TrimPrefix(filepath.Ext(absPath), ".")
+	result := []string{own}
+	for _, ext := range Extensions {
+		if ext != own {
+			result = append(result, ext)
+		}
+	}
+	return result
+}
+
+// resolveBase resolves an import path without extension to a file, the same
+// way Sass does: trying the plain file, then the partial file prefixed with an
+// underscore, and then the index file of a dir with that name.
+func resolveBase(base string, exts []string) string {
+	dir, name := filepath.Dir(base), filepath.Base(base)
+	if utils.EndsWith(name, Extensions) {
+		for _, candidate := range []string{base, filepath.Join(dir, "_"+name)} {
+			if utils.FileExists(candidate) {
+				return candidate
+			}
+		}
+		return ""
+	}
+	var candidates []string
+	for _, ext := range exts {
+		candidates = append(candidates, filepath.Join(dir, name+"."+ext), filepath.Join(dir, "_"+name+"."+ext))
+	}
+	for _, ext := range exts {
+		candidates = append(candidates, filepath.Join(base, "index."+ext), filepath.Join(base, "_index."+ext))
+	}
+	for _, candidate := range candidates {
+		if utils.FileExists(candidate) {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// resolve resolves an imported path relative to the importing file, then to the
+// configured load paths. Paths prefixed with ~ are resolved from node_modules, like
+// webpack's sass-loader does.
+func (l *Language) resolve(path string, absPath string) string {
+	if external(path) {
+		return ""
+	}
+	var bases []string
+	switch {
+	case filepath.IsAbs(path):
+		bases = []string{path}
+	case strings.HasPrefix(path, "~"):
+		if nodeModules := findNodeModules(filepath.Dir(absPath)); nodeModules != "" {
+			bases = []string{filepath.Join(nodeModules, strings.TrimPrefix(path, "~"))}
+		}
+	default:
+		bases = []string{filepath.Join(filepath.Dir(absPath), path)}
+		for _, dir := range l.cfg.LoadPaths {
+			if abs, err := filepath.Abs(dir); err == nil {
+				bases = append(bases, filepath.Join(abs, path))
+			}
+		}
+	}
+
+	exts := extensions(absPath)
+	for _, base := range bases {
+		if resolved := resolveBase(base, exts); resolved != "" {
+			return resolved
+		}
+	}
+	return ""
+}
@@ -0,0 +1,10 @@
+import 'dart:io';
+
+import 'package:http/http.dart' as http;
+import 'package:my_app/my_app.dart';
+import '../lib/src/utils.dart' show format;
+import 'missing.dart';
+
+void main() {
+  print(format(User('foo').name));
+}
@@ -0,0 +1,5 @@
+library my_app;
+
+export 'src/models.dart' show User;
+export 'src/utils.dart' hide internalHelper;
+export 'package:shared/shared.dart';
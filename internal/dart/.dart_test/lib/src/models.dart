@@ -0,0 +1,11 @@
+import 'package:shared/shared.dart';
+
+part 'models.g.dart';
+
+class User extends Shared {
+  final String name;
+
+  User(this.name);
+}
+
+class _Cache {}
@@ -0,0 +1,3 @@
+part of 'models.dart';
+
+User userFromJson(Map<String, dynamic> json) => User(json['name'] as String);
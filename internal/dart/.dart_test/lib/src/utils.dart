@@ -0,0 +1,5 @@
+String format(String s) => s.trim();
+
+void internalHelper() {}
+
+const version = '1.0.0';
@@ -0,0 +1,3 @@
+package dart
+
+type Config struct{}
@@ -0,0 +1,85 @@
+//nolint:govet
+package dart_grammar
+
+import "strings"
+
+// Declaration is a class-like top level declaration, e.g.
+// abstract class Foo<T> extends Bar with Baz implements Qux { ... }.
+type Declaration struct {
+	Modifiers []string `@("abstract" | "base" | "final" | "interface" | "sealed" | "macro")*`
+	Kind      string   `@("class" | "enum" | "extension" "type" | "extension" | "mixin" "class" | "mixin")`
+	Name      string   `@Ident?`
+	Rest      []string `(~("{" | ";" | "}"))*`
+	Body      *Block   `(@@ | ";")`
+}
+
+// Private returns whether the declaration is private to its library.
+func (d *Declaration) Private() bool {
+	return d.Name == "" || d.Name == "on" || strings.HasPrefix(d.Name, "_")
+}
+
+// Member is any other top level declaration, like functions, getters, setters,
+// variables or typedefs.
+type Member struct {
+	Tokens []string `@(~("{" | ";" | "}"))+`
+	Body   *Block   `(@@ | ";")`
+}
+
+// Name returns the name of the declared member, which is the last identifier
+// before the parameters or the initializer, ignoring type arguments.
+func (m *Member) Name() string {
+	name := ""
+	depth := 0
+	for _, token := range m.Tokens {
+		switch token {
+		case "(", "=", "=>", ";", ",":
+			return name
+		case "<":
+			depth++
+		case ">":
+			depth--
+		default:
+			if depth == 0 && isIdent(token) && !keywords[token] {
+				name = token
+			}
+		}
+	}
+	return name
+}
+
+// Private returns whether the member is private to its library.
+func (m *Member) Private() bool {
+	name := m.Name()
+	return name == "" || strings.HasPrefix(name, "_")
+}
+
+var keywords = map[string]bool{
+	"get":      true,
+	"set":      true,
+	"external": true,
+	"late":     true,
+	"final":    true,
+	"const":    true,
+	"var":      true,
+	"static":   true,
+	"typedef":  true,
+	"void":     true,
+	"dynamic":  true,
+	"Function": true,
+	"async":    true,
+	"operator": true,
+}
+
+func isIdent(token string) bool {
+	if token == "" {
+		return false
+	}
+	for i, c := range token {
+		isLetter := c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		isDigit := c >= '0' && c <= '9'
+		if !isLetter && !(i > 0 && isDigit) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+//nolint:govet
+package dart_grammar
+
+type Library struct {
+	Name []string `"library" (@Ident ("." @Ident)*)? ";"`
+}
+
+// Combinator restricts the names brought by an import or an export, e.g.
+// show Foo, Bar or hide Baz.
+type Combinator struct {
+	Kind  string   `@("show" | "hide")`
+	Names []string `@Ident ("," @Ident)*`
+}
+
+// Configuration is a conditional uri, e.g. if (dart.library.io) 'io.dart'.
+// Only the default uri is taken into account.
+type Configuration struct {
+	Condition *Parens `"if" @@`
+	Uri       string  `@String`
+}
+
+type Import struct {
+	Uri            string           `"import" @String`
+	Configurations []*Configuration `@@*`
+	Deferred       bool             `@"deferred"?`
+	Prefix         string           `("as" @Ident)?`
+	Combinators    []*Combinator    `@@* ";"`
+}
+
+type Export struct {
+	Uri            string           `"export" @String`
+	Configurations []*Configuration `@@*`
+	Combinators    []*Combinator    `@@* ";"`
+}
+
+type Part struct {
+	Uri string `"part" @String ";"`
+}
+
+// PartOf references the library that owns the file, either by uri or by its
+// (deprecated) library name.
+type PartOf struct {
+	Uri     string   `"part" "of" (@String`
+	Library []string `| @Ident ("." @Ident)*) ";"`
+}
+
+// Show returns the names in show combinators, or nil if there are none.
+func (i *Import) Show() []string {
+	return namesOf(i.Combinators, "show")
+}
+
+// Hide returns the names in hide combinators, or nil if there are none.
+func (i *Import) Hide() []string {
+	return namesOf(i.Combinators, "hide")
+}
+
+// Show returns the names in show combinators, or nil if there are none.
+func (e *Export) Show() []string {
+	return namesOf(e.Combinators, "show")
+}
+
+// Hide returns the names in hide combinators, or nil if there are none.
+func (e *Export) Hide() []string {
+	return namesOf(e.Combinators, "hide")
+}
+
+func namesOf(combinators []*Combinator, kind string) []string {
+	var result []string
+	for _, combinator := range combinators {
+		if combinator.Kind == kind {
+			result = append(result, combinator.Names...)
+		}
+	}
+	return result
+}
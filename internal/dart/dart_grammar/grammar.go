@@ -0,0 +1,85 @@
+//nolint:govet
+package dart_grammar
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+type Statement struct {
+	Pos         lexer.Position
+	Library     *Library     `  @@`
+	Import      *Import      `| @@`
+	Export      *Export      `| @@`
+	PartOf      *PartOf      `| @@`
+	Part        *Part        `| @@`
+	Annotation  *Annotation  `| @@`
+	Declaration *Declaration `| @@`
+	Member      *Member      `| @@`
+	// Block swallows anything between braces that was not matched by the
+	// statements above, so that braces are always balanced.
+	Block *Block `| @@`
+}
+
+type Block struct {
+	Blocks []*Block `"{" (@@ | ~("{" | "}"))* "}"`
+}
+
+type Parens struct {
+	Parens []*Parens `"(" (@@ | ~("(" | ")"))* ")"`
+}
+
+// Annotation is swallowed so that it is not mistaken for a declaration, e.g.
+// @JsonSerializable(explicitToJson: true).
+type Annotation struct {
+	Name   string  `"@" @Ident ("." @Ident)*`
+	Parens *Parens `@@?`
+}
+
+type File struct {
+	Statements []*Statement `(@@ | ~"{")*`
+}
+
+var (
+	lex = lexer.MustSimple(
+		[]lexer.SimpleRule{
+			{"Comment", `//.*|/\*(.|\n)*?\*/`},
+			{"RawString", `r'''(?:.|\n)*?'''|r"""(?:.|\n)*?"""|r'[^'\n]*'|r"[^"\n]*"`},
+			{"TripleString", `'''(?:.|\n)*?'''|"""(?:.|\n)*?"""`},
+			{"String", `'(?:\\.|\$\{[^}]*\}|[^'\\\n])*'|"(?:\\.|\$\{[^}]*\}|[^"\\\n])*"`},
+			{"Ident", `[_$a-zA-Z][_$a-zA-Z0-9]*`},
+			{"Arrow", `=>`},
+			{"Punct", `[^\s_$a-zA-Z0-9'"]`},
+			{"Whitespace", `\s+`},
+			{"ANY", `.`},
+		},
+	)
+	parser = participle.MustBuild[File](
+		participle.Lexer(lex),
+		participle.Elide("Whitespace", "Comment"),
+		utils.UnquoteSafe("String"),
+		participle.UseLookahead(1024),
+	)
+)
+
+func Parse(filePath string) (*language.FileInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseBytes(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	return &language.FileInfo{
+		Content: file,
+		Loc:     bytes.Count(content, []byte("\n")),
+		Size:    len(content),
+		AbsPath: filePath,
+	}, nil
+}
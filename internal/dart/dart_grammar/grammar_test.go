@@ -0,0 +1,118 @@
+package dart_grammar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		Name             string
+		ExpectedImports  []Import
+		ExpectedExports  []Export
+		ExpectedParts    []string
+		ExpectedPartOf   *PartOf
+		ExpectedDeclared []string
+	}{
+		{
+			Name: "library app;\nimport 'package:app/a.dart';\nimport \"dart:io\" as io;\nimport 'b.dart' deferred as b show B1, B2 hide C;",
+			ExpectedImports: []Import{
+				{Uri: "package:app/a.dart"},
+				{Uri: "dart:io", Prefix: "io"},
+				{Uri: "b.dart", Deferred: true, Prefix: "b", Combinators: []*Combinator{
+					{Kind: "show", Names: []string{"B1", "B2"}},
+					{Kind: "hide", Names: []string{"C"}},
+				}},
+			},
+		},
+		{
+			Name: "import 'stub.dart' if (dart.library.io) 'io.dart' if (dart.library.html) 'web.dart';\nexport 'src/a.dart' show A;\nexport 'src/b.dart' hide _B, C;",
+			ExpectedImports: []Import{
+				{Uri: "stub.dart", Configurations: []*Configuration{
+					{Condition: &Parens{}, Uri: "io.dart"},
+					{Condition: &Parens{}, Uri: "web.dart"},
+				}},
+			},
+			ExpectedExports: []Export{
+				{Uri: "src/a.dart", Combinators: []*Combinator{{Kind: "show", Names: []string{"A"}}}},
+				{Uri: "src/b.dart", Combinators: []*Combinator{{Kind: "hide", Names: []string{"_B", "C"}}}},
+			},
+		},
+		{
+			Name:           "part of 'models.dart';\n\nUser userFromJson(Map<String, dynamic> json) => User();",
+			ExpectedPartOf: &PartOf{Uri: "models.dart"},
+			ExpectedDeclared: []string{
+				"userFromJson",
+			},
+		},
+		{
+			Name:           "part of my.lib;",
+			ExpectedPartOf: &PartOf{Library: []string{"my", "lib"}},
+		},
+		{
+			Name:          "part 'a.g.dart';\n@JsonSerializable(explicitToJson: true)\nabstract base class Foo<T extends Comparable<T>> extends Bar with Baz implements Qux {\n  void method() { if (true) {} }\n}\nmixin M on Foo {}\nmixin class MC {}\nenum Color { red, green }\nextension on String {}\nextension StringX on String {}\nsealed class _Private {}",
+			ExpectedParts: []string{"a.g.dart"},
+			ExpectedDeclared: []string{
+				"class Foo",
+				"mixin M",
+				"mixinclass MC",
+				"enum Color",
+				"extension StringX",
+			},
+		},
+		{
+			Name: "void main() {\n  print('}');\n}\nFuture<List<int>> load<T>(T x) async => [];\nint get count => 1;\nset count(int v) {}\nfinal String? name = null;\nconst version = '1.0', other = 2;\nlate final _cache = <String, int>{};\ntypedef Callback = void Function(int);\ntypedef void OldCallback(int x);\nfinal handlers = {'a': () {}};",
+			ExpectedDeclared: []string{
+				"main",
+				"load",
+				"count",
+				"count",
+				"name",
+				"version",
+				"Callback",
+				"OldCallback",
+				"handlers",
+			},
+		},
+		{
+			Name:             "// class Commented {}\n/* import 'nope.dart'; */\nvar s = r'raw ${not} interpolated';\nvar t = '''\nclass NotAClass {}\n''';",
+			ExpectedDeclared: []string{"s", "t"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			parsed, err := parser.ParseBytes("", []byte(tt.Name))
+			a.NoError(err)
+
+			var imports []Import
+			var exports []Export
+			var parts []string
+			var partOf *PartOf
+			var declared []string
+			for _, stmt := range parsed.Statements {
+				switch {
+				case stmt.Import != nil:
+					imports = append(imports, *stmt.Import)
+				case stmt.Export != nil:
+					exports = append(exports, *stmt.Export)
+				case stmt.Part != nil:
+					parts = append(parts, stmt.Part.Uri)
+				case stmt.PartOf != nil:
+					partOf = stmt.PartOf
+				case stmt.Declaration != nil && !stmt.Declaration.Private():
+					declared = append(declared, stmt.Declaration.Kind+" "+stmt.Declaration.Name)
+				case stmt.Member != nil && !stmt.Member.Private():
+					declared = append(declared, stmt.Member.Name())
+				}
+			}
+			a.Equal(tt.ExpectedImports, imports)
+			a.Equal(tt.ExpectedExports, exports)
+			a.Equal(tt.ExpectedParts, parts)
+			a.Equal(tt.ExpectedPartOf, partOf)
+			a.Equal(tt.ExpectedDeclared, declared)
+		})
+	}
+}
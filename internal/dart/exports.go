@@ -0,0 +1,128 @@
+package dart
+
+import (
+	"github.com/gabotechs/dep-tree/internal/dart/dart_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	stack := utils.NewCallStack()
+	_ = stack.Push(file.AbsPath)
+	return l.parseExports(file, stack)
+}
+
+func (l *Language) parseExports(file *language.FileInfo, stack *utils.CallStack) (*language.ExportsResult, error) {
+	exports := make([]language.ExportEntry, 0)
+	var errors []error
+
+	content := file.Content.(*dart_grammar.File)
+	var symbols []language.ExportSymbol
+	for _, stmt := range content.Statements {
+		switch {
+		case stmt.Export != nil:
+			absPath, err := resolve(stmt.Export.Uri, file.AbsPath)
+			if err != nil {
+				errors = append(errors, err)
+				continue
+			} else if absPath == "" {
+				continue
+			}
+			if show := stmt.Export.Show(); len(show) > 0 {
+				exports = append(exports, language.ExportEntry{
+					Symbols: toExportSymbols(show),
+					AbsPath: absPath,
+				})
+			} else if hide := stmt.Export.Hide(); len(hide) > 0 {
+				names, err := l.exportedNames(absPath, stack)
+				if err != nil {
+					errors = append(errors, err)
+					continue
+				}
+				var shown []string
+				for _, name := range names {
+					if !utils.InArray(name, hide) {
+						shown = append(shown, name)
+					}
+				}
+				exports = append(exports, language.ExportEntry{
+					Symbols: toExportSymbols(shown),
+					AbsPath: absPath,
+				})
+			} else {
+				exports = append(exports, language.ExportEntry{
+					All:     true,
+					AbsPath: absPath,
+				})
+			}
+		case stmt.Part != nil:
+			// Declarations in parts belong to the library.
+			absPath, err := resolve(stmt.Part.Uri, file.AbsPath)
+			if err != nil {
+				errors = append(errors, err)
+			} else if absPath != "" {
+				exports = append(exports, language.ExportEntry{
+					All:     true,
+					AbsPath: absPath,
+				})
+			}
+		case stmt.Declaration != nil && !stmt.Declaration.Private():
+			symbols = append(symbols, language.ExportSymbol{Original: stmt.Declaration.Name})
+		case stmt.Member != nil && !stmt.Member.Private():
+			symbols = append(symbols, language.ExportSymbol{Original: stmt.Member.Name()})
+		}
+	}
+	if len(symbols) > 0 {
+		exports = append(exports, language.ExportEntry{
+			Symbols: symbols,
+			AbsPath: file.AbsPath,
+		})
+	}
+
+	return &language.ExportsResult{
+		Exports: exports,
+		Errors:  errors,
+	}, nil
+}
+
+// exportedNames returns all the names exported by a file, including the ones it
+// re-exports from other files, so that hide combinators can be turned into the
+// list of names that are actually exported.
+func (l *Language) exportedNames(absPath string, stack *utils.CallStack) ([]string, error) {
+	if err := stack.Push(absPath); err != nil {
+		return nil, err
+	}
+	defer stack.Pop()
+
+	file, err := l.ParseFile(absPath)
+	if err != nil {
+		return nil, err
+	}
+	exports, err := l.parseExports(file, stack)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, export := range exports.Exports {
+		if export.All {
+			unwrapped, err := l.exportedNames(export.AbsPath, stack)
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, unwrapped...)
+			continue
+		}
+		for _, symbol := range export.Symbols {
+			names = append(names, symbol.Original)
+		}
+	}
+	return names, nil
+}
+
+func toExportSymbols(names []string) []language.ExportSymbol {
+	symbols := make([]language.ExportSymbol, len(names))
+	for i, name := range names {
+		symbols[i] = language.ExportSymbol{Original: name}
+	}
+	return symbols
+}
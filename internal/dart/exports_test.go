@@ -0,0 +1,95 @@
+package dart
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func TestLanguage_ParseExports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+	lib := filepath.Join(absTestFolder, "lib")
+
+	tests := []struct {
+		Name     string
+		File     string
+		Expected []language.ExportEntry
+	}{
+		{
+			Name: "barrel file",
+			File: filepath.Join(lib, "my_app.dart"),
+			Expected: []language.ExportEntry{
+				{
+					Symbols: []language.ExportSymbol{{Original: "User"}},
+					AbsPath: filepath.Join(lib, "src", "models.dart"),
+				},
+				{
+					Symbols: []language.ExportSymbol{{Original: "format"}, {Original: "version"}},
+					AbsPath: filepath.Join(lib, "src", "utils.dart"),
+				},
+				{
+					All:     true,
+					AbsPath: filepath.Join(absTestFolder, "packages", "shared", "lib", "shared.dart"),
+				},
+			},
+		},
+		{
+			Name: "library with parts",
+			File: filepath.Join(lib, "src", "models.dart"),
+			Expected: []language.ExportEntry{
+				{
+					All:     true,
+					AbsPath: filepath.Join(lib, "src", "models.g.dart"),
+				},
+				{
+					Symbols: []language.ExportSymbol{{Original: "User"}},
+					AbsPath: filepath.Join(lib, "src", "models.dart"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeDartLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			exports, err := lang.ParseExports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, exports.Exports)
+			a.Nil(exports.Errors)
+		})
+	}
+}
+
+func TestParser_UnwrapProxyExports(t *testing.T) {
+	a := require.New(t)
+	absTestFolder, _ := filepath.Abs(testFolder)
+
+	lang, err := MakeDartLanguage(nil)
+	a.NoError(err)
+	parser := language.NewParser(lang)
+	parser.UnwrapProxyExports = true
+
+	node, err := parser.Node(filepath.Join(absTestFolder, "bin", "main.dart"))
+	a.NoError(err)
+	deps, err := parser.Deps(node)
+	a.NoError(err)
+
+	var result []string
+	for _, dep := range deps {
+		result = append(result, dep.Data.RelPath)
+	}
+	a.Equal([]string{
+		filepath.Join("lib", "src", "models.dart"),
+		filepath.Join("lib", "src", "utils.dart"),
+		filepath.Join("lib", "shared.dart"),
+	}, result)
+}
@@ -0,0 +1,46 @@
+package dart
+
+import (
+	"github.com/gabotechs/dep-tree/internal/dart/dart_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	result := language.ImportsResult{Imports: make([]language.ImportEntry, 0)}
+
+	content := file.Content.(*dart_grammar.File)
+	for _, stmt := range content.Statements {
+		switch {
+		case stmt.Import != nil:
+			absPath, err := resolve(stmt.Import.Uri, file.AbsPath)
+			if err != nil {
+				result.Errors = append(result.Errors, err)
+				continue
+			} else if absPath == "" {
+				continue
+			}
+			// Names hidden from an import cannot be expressed in an ImportEntry,
+			// so imports with a hide combinator are considered to import everything.
+			entry := language.AllImport(absPath)
+			if show := stmt.Import.Show(); len(show) > 0 {
+				entry = language.SymbolsImport(show, absPath)
+			}
+			entry.Line, entry.Column = stmt.Pos.Line, stmt.Pos.Column
+			result.Imports = append(result.Imports, entry)
+		case stmt.Part != nil:
+			// A library depends on its parts. Part files share the imports of their
+			// library, so `part of` directives do not introduce a dependency back
+			// to it, which would make every library and its parts a cycle.
+			absPath, err := resolve(stmt.Part.Uri, file.AbsPath)
+			if err != nil {
+				result.Errors = append(result.Errors, err)
+			} else if absPath != "" {
+				entry := language.EmptyImport(absPath)
+				entry.Line, entry.Column = stmt.Pos.Line, stmt.Pos.Column
+				result.Imports = append(result.Imports, entry)
+			}
+		}
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,74 @@
+package dart
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".dart_test"
+
+func TestLanguage_ParseImports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+	lib := filepath.Join(absTestFolder, "lib")
+
+	tests := []struct {
+		Name           string
+		File           string
+		Expected       []language.ImportEntry
+		ExpectedErrors []string
+	}{
+		{
+			Name: "package and relative uris",
+			File: filepath.Join(absTestFolder, "bin", "main.dart"),
+			Expected: []language.ImportEntry{
+				at(language.AllImport(filepath.Join(lib, "my_app.dart")), 4, 1),
+				at(language.SymbolsImport([]string{"format"}, filepath.Join(lib, "src", "utils.dart")), 5, 1),
+			},
+			ExpectedErrors: []string{
+				"could not resolve \"missing.dart\"",
+			},
+		},
+		{
+			Name: "path dependencies and parts",
+			File: filepath.Join(lib, "src", "models.dart"),
+			Expected: []language.ImportEntry{
+				at(language.AllImport(filepath.Join(absTestFolder, "packages", "shared", "lib", "shared.dart")), 1, 1),
+				at(language.EmptyImport(filepath.Join(lib, "src", "models.g.dart")), 3, 1),
+			},
+		},
+		{
+			Name:     "part of",
+			File:     filepath.Join(lib, "src", "models.g.dart"),
+			Expected: []language.ImportEntry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeDartLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			imports, err := lang.ParseImports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, imports.Imports)
+			var errs []string
+			for _, err := range imports.Errors {
+				errs = append(errs, err.Error())
+			}
+			a.Equal(tt.ExpectedErrors, errs)
+		})
+	}
+}
+
+func at(entry language.ImportEntry, line, column int) language.ImportEntry {
+	entry.Line, entry.Column = line, column
+	return entry
+}
@@ -0,0 +1,52 @@
+package dart
+
+import (
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/dart/dart_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var Extensions = []string{
+	"dart",
+}
+
+type Language struct {
+	cfg *Config
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+
+func MakeDartLanguage(cfg *Config) (language.Language, error) {
+	lang := Language{
+		cfg: cfg,
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+	return &lang, nil
+}
+
+var parseDartFile, evictDartFile = utils.EvictableCached1In1OutErr(dart_grammar.Parse)
+
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	parsed, err := parseDartFile(id)
+	if err != nil {
+		return nil, err
+	}
+	file := *parsed
+	if pubspec := pubspecOf(id); pubspec != nil {
+		file.Package = pubspec.Name
+		file.RelPath, _ = filepath.Rel(pubspec.AbsDir, id)
+	} else {
+		file.RelPath = filepath.Base(id)
+	}
+	return &file, nil
+}
+
+// Invalidate drops the provided files.
+func (l *Language) Invalidate(absPaths ...string) {
+	evictDartFile(utils.IsAnyOf(absPaths))
+}
@@ -0,0 +1,43 @@
+package dart
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguage_ParseFile(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Path            string
+		ExpectedRelPath string
+		ExpectedPackage string
+	}{
+		{
+			Name:            "application file",
+			Path:            filepath.Join(testFolder, "lib", "src", "models.dart"),
+			ExpectedRelPath: "lib/src/models.dart",
+			ExpectedPackage: "my_app",
+		},
+		{
+			Name:            "path dependency file",
+			Path:            filepath.Join(testFolder, "packages", "shared", "lib", "shared.dart"),
+			ExpectedRelPath: "lib/shared.dart",
+			ExpectedPackage: "shared",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeDartLanguage(nil)
+			a.NoError(err)
+			absPath, _ := filepath.Abs(tt.Path)
+			file, err := lang.ParseFile(absPath)
+			a.NoError(err)
+			a.Equal(tt.ExpectedPackage, file.Package)
+			a.Equal(tt.ExpectedRelPath, file.RelPath)
+		})
+	}
+}
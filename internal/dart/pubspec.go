@@ -0,0 +1,123 @@
+package dart
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var findPubspec = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	"pubspec.yaml",
+})
+
+var findPackageConfig = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	filepath.Join(".dart_tool", "package_config.json"),
+})
+
+type Pubspec struct {
+	Name   string `yaml:"name"`
+	AbsDir string `yaml:"-"`
+}
+
+func _readPubspec(dir string) (*Pubspec, error) {
+	content, err := os.ReadFile(filepath.Join(dir, "pubspec.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	var pubspec Pubspec
+	err = yaml.Unmarshal(content, &pubspec)
+	if err != nil {
+		return nil, err
+	}
+	pubspec.AbsDir = dir
+	return &pubspec, nil
+}
+
+var readPubspec = utils.Cached1In1OutErr(_readPubspec)
+
+// pubspecOf returns the pubspec.yaml of the package where a file is located, or
+// nil if it does not belong to any.
+func pubspecOf(absPath string) *Pubspec {
+	root := findPubspec(filepath.Dir(absPath))
+	if root == nil {
+		return nil
+	}
+	pubspec, err := readPubspec(root.AbsDir)
+	if err != nil {
+		return nil
+	}
+	return pubspec
+}
+
+type packageConfigEntry struct {
+	Name       string `json:"name"`
+	RootUri    string `json:"rootUri"`
+	PackageUri string `json:"packageUri"`
+}
+
+type packageConfig struct {
+	Packages []packageConfigEntry `json:"packages"`
+}
+
+// _readPackageConfig reads the .dart_tool/package_config.json file generated by
+// `pub get` in the provided dir, and returns the absolute path of the dir from
+// which `package:` uris are resolved for each package. Packages that live outside
+// the project, like the ones downloaded to the pub cache, are not included.
+func _readPackageConfig(dir string) (map[string]string, error) {
+	configDir := filepath.Join(dir, ".dart_tool")
+	content, err := os.ReadFile(filepath.Join(configDir, "package_config.json"))
+	if err != nil {
+		return nil, err
+	}
+	var config packageConfig
+	err = json.Unmarshal(content, &config)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]string)
+	for _, pkg := range config.Packages {
+		rootUri, err := url.Parse(pkg.RootUri)
+		if err != nil || rootUri.IsAbs() {
+			continue
+		}
+		packageUri := pkg.PackageUri
+		if packageUri == "" {
+			packageUri = "lib/"
+		}
+		result[pkg.Name] = filepath.Join(configDir, filepath.FromSlash(rootUri.Path), filepath.FromSlash(packageUri))
+	}
+	return result, nil
+}
+
+var readPackageConfig = utils.Cached1In1OutErr(_readPackageConfig)
+
+// resolvePackageUri resolves a uri like package:my_app/src/foo.dart imported
+// from the provided file. The package where the file is located is resolved using
+// its pubspec.yaml, any other package using the closest package_config.json.
+func resolvePackageUri(uri string, from string) string {
+	pkgName, path, ok := strings.Cut(strings.TrimPrefix(uri, "package:"), "/")
+	if !ok {
+		return ""
+	}
+	if pubspec := pubspecOf(from); pubspec != nil && pubspec.Name == pkgName {
+		return filepath.Join(pubspec.AbsDir, "lib", filepath.FromSlash(path))
+	}
+	root := findPackageConfig(filepath.Dir(from))
+	if root == nil {
+		return ""
+	}
+	packages, err := readPackageConfig(root.AbsDir)
+	if err != nil {
+		return ""
+	}
+	if libDir, ok := packages[pkgName]; ok {
+		return filepath.Join(libDir, filepath.FromSlash(path))
+	}
+	return ""
+}
@@ -0,0 +1,27 @@
+package dart
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// resolve resolves a uri referenced from a file. Dart SDK libraries and packages
+// that are not part of the project resolve to an empty string.
+func resolve(uri string, from string) (string, error) {
+	switch {
+	case strings.HasPrefix(uri, "dart:"):
+		return "", nil
+	case strings.HasPrefix(uri, "package:"):
+		return resolvePackageUri(uri, from), nil
+	case strings.Contains(uri, ":"):
+		return "", nil
+	}
+	absPath := filepath.Join(filepath.Dir(from), filepath.FromSlash(uri))
+	if !utils.FileExists(absPath) {
+		return "", fmt.Errorf("could not resolve %q", uri)
+	}
+	return absPath, nil
+}
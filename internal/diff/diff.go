@@ -0,0 +1,250 @@
+package diff
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/check"
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/metrics"
+)
+
+const (
+	Added   = "added"
+	Removed = "removed"
+)
+
+// metricsColumns are the metrics compared between revisions. The rest of them, like
+// PageRank, change slightly in most of the files with any change in the graph.
+var metricsColumns = []string{"afferent", "efferent", "instability", "depth", "transitive"}
+
+// Revision is the dependency graph of the project at some git revision.
+type Revision struct {
+	// Name is the git revision, like a branch name or a commit hash.
+	Name string
+	// Dir is the directory where the files of the revision were extracted.
+	Dir         string
+	Graph       *graph.Graph[*language.FileInfo]
+	Entrypoints []string
+	// Check are the rules for finding new violations, nil if there are none.
+	Check *check.Config
+}
+
+// key is the path of the file relative to the root of the repository, which is the
+// same in both revisions.
+func (r *Revision) key(id string) string {
+	rel, err := filepath.Rel(r.Dir, id)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return id
+	}
+	return filepath.ToSlash(rel)
+}
+
+func (r *Revision) keyDisplay(node *graph.Node[*language.FileInfo]) string {
+	return r.key(node.Id)
+}
+
+// edges returns the edges of the graph keyed by the files that they connect.
+func (r *Revision) edges() map[[2]string]Edge {
+	result := map[[2]string]Edge{}
+	for _, node := range r.Graph.AllNodes() {
+		for _, dep := range r.Graph.FromId(node.Id) {
+			data := r.Graph.EdgeData(node.Id, dep.Id)
+			from, to := r.key(node.Id), r.key(dep.Id)
+			result[[2]string{from, to}] = Edge{
+				From:        from,
+				To:          to,
+				Line:        data.Line(),
+				Description: data.Describe(),
+			}
+		}
+	}
+	return result
+}
+
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Line is the line of From where To is imported, or 0 if it's not known.
+	Line int `json:"line,omitempty"`
+	// Description tells what From imports from To, if it's known.
+	Description string `json:"description,omitempty"`
+}
+
+// MetricsChange are the metrics of a file that is present in both revisions.
+type MetricsChange struct {
+	File   string          `json:"file"`
+	Before metrics.Metrics `json:"before"`
+	After  metrics.Metrics `json:"after"`
+}
+
+type Diff struct {
+	From          string            `json:"from"`
+	To            string            `json:"to"`
+	AddedFiles    []string          `json:"addedFiles"`
+	RemovedFiles  []string          `json:"removedFiles"`
+	AddedEdges    []Edge            `json:"addedEdges"`
+	RemovedEdges  []Edge            `json:"removedEdges"`
+	NewCycles     [][]string        `json:"newCycles"`
+	Metrics       []MetricsChange   `json:"metrics"`
+	NewViolations []check.Violation `json:"newViolations"`
+}
+
+// Compute compares the dependency graphs of two revisions. Files are identified by their
+// path relative to the root of the repository, so they are the same in both revisions.
+func Compute(a, b *Revision) (*Diff, error) {
+	d := &Diff{
+		From:          a.Name,
+		To:            b.Name,
+		AddedFiles:    []string{},
+		RemovedFiles:  []string{},
+		AddedEdges:    []Edge{},
+		RemovedEdges:  []Edge{},
+		NewCycles:     [][]string{},
+		Metrics:       []MetricsChange{},
+		NewViolations: []check.Violation{},
+	}
+
+	before := map[string]metrics.Metrics{}
+	for _, m := range metrics.Compute(a.Graph, a.Entrypoints, a.keyDisplay, abstractness) {
+		before[m.Name] = m
+	}
+	after := map[string]bool{}
+	for _, m := range metrics.Compute(b.Graph, b.Entrypoints, b.keyDisplay, abstractness) {
+		after[m.Name] = true
+		if previous, ok := before[m.Name]; !ok {
+			d.AddedFiles = append(d.AddedFiles, m.Name)
+		} else if change := (MetricsChange{File: m.Name, Before: previous, After: m}); len(change.Changed()) > 0 {
+			d.Metrics = append(d.Metrics, change)
+		}
+	}
+	for file := range before {
+		if !after[file] {
+			d.RemovedFiles = append(d.RemovedFiles, file)
+		}
+	}
+
+	edgesA, edgesB := a.edges(), b.edges()
+	for key, edge := range edgesB {
+		if _, ok := edgesA[key]; !ok {
+			d.AddedEdges = append(d.AddedEdges, edge)
+		}
+	}
+	for key, edge := range edgesA {
+		if _, ok := edgesB[key]; !ok {
+			d.RemovedEdges = append(d.RemovedEdges, edge)
+		}
+	}
+
+	slices.Sort(d.AddedFiles)
+	slices.Sort(d.RemovedFiles)
+	slices.SortFunc(d.AddedEdges, compareEdges)
+	slices.SortFunc(d.RemovedEdges, compareEdges)
+	slices.SortFunc(d.Metrics, func(x, y MetricsChange) int {
+		return strings.Compare(x.File, y.File)
+	})
+
+	d.NewCycles = newCycles(b, d.AddedEdges)
+
+	violations, err := newViolations(a, b)
+	if err != nil {
+		return nil, err
+	}
+	d.NewViolations = violations
+	return d, nil
+}
+
+func abstractness(node *graph.Node[*language.FileInfo]) *float64 {
+	return node.Data.Abstractness()
+}
+
+func compareEdges(x, y Edge) int {
+	if c := strings.Compare(x.From, y.From); c != 0 {
+		return c
+	}
+	return strings.Compare(x.To, y.To)
+}
+
+// Changed returns the names of the compared metrics that are different in both revisions.
+func (c MetricsChange) Changed() []string {
+	var result []string
+	for _, column := range metrics.Columns {
+		if slices.Contains(metricsColumns, column.Name) && column.Format(c.Before) != column.Format(c.After) {
+			result = append(result, column.Name)
+		}
+	}
+	return result
+}
+
+// newCycles returns the shortest circular dependency introduced by each one of the added
+// edges, without duplicates. Cycles start in the file that is lower alphabetically.
+func newCycles(b *Revision, added []Edge) [][]string {
+	ids := map[string]string{}
+	for _, node := range b.Graph.AllNodes() {
+		ids[b.key(node.Id)] = node.Id
+	}
+	result := [][]string{}
+	seen := map[string]bool{}
+	for _, edge := range added {
+		stack := b.Graph.ShortestCycleThrough(ids[edge.From], ids[edge.To])
+		if stack == nil {
+			continue
+		}
+		cycle := make([]string, len(stack)-1)
+		lowest := 0
+		for i, id := range stack[:len(stack)-1] {
+			cycle[i] = b.key(id)
+			if cycle[i] < cycle[lowest] {
+				lowest = i
+			}
+		}
+		rotated := append(append([]string{}, cycle[lowest:]...), cycle[:lowest+1]...)
+		key := strings.Join(rotated, "\x00")
+		if !seen[key] {
+			seen[key] = true
+			result = append(result, rotated)
+		}
+	}
+	return result
+}
+
+// newViolations returns the check violations in b that were not present in a.
+func newViolations(a, b *Revision) ([]check.Violation, error) {
+	result := []check.Violation{}
+	if b.Check == nil {
+		return result, nil
+	}
+	previous := map[[2]string]bool{}
+	if a.Check != nil {
+		violations, err := check.Violations(a.Graph, a.Check)
+		if err != nil {
+			return nil, err
+		}
+		for _, violation := range violations {
+			previous[[2]string{violation.From, violation.To}] = true
+		}
+	}
+	violations, err := check.Violations(b.Graph, b.Check)
+	if err != nil {
+		return nil, err
+	}
+	for _, violation := range violations {
+		if !previous[[2]string{violation.From, violation.To}] {
+			result = append(result, violation)
+		}
+	}
+	return result, nil
+}
+
+// Empty tells if there are no differences between the dependency graphs of both revisions.
+func (d *Diff) Empty() bool {
+	return len(d.AddedFiles) == 0 &&
+		len(d.RemovedFiles) == 0 &&
+		len(d.AddedEdges) == 0 &&
+		len(d.RemovedEdges) == 0 &&
+		len(d.NewCycles) == 0 &&
+		len(d.Metrics) == 0 &&
+		len(d.NewViolations) == 0
+}
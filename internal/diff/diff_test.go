@@ -0,0 +1,140 @@
+package diff
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/check"
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+// makeRevision builds a revision in dir with the provided imports between files.
+func makeRevision(t *testing.T, name, dir string, entrypoint string, edges [][2]string) *Revision {
+	g := graph.NewGraph[*language.FileInfo]()
+	add := func(file string) string {
+		id := filepath.Join(dir, file)
+		if !g.Has(id) {
+			require.NoError(t, g.AddNode(graph.MakeNode(id, &language.FileInfo{AbsPath: id, RelPath: file})))
+		}
+		return id
+	}
+	add(entrypoint)
+	for _, edge := range edges {
+		require.NoError(t, g.AddEdge(add(edge[0]), add(edge[1]), &graph.EdgeData{Imports: []graph.EdgeImport{{Symbols: []string{"foo"}, Line: 1}}}))
+	}
+	return &Revision{
+		Name:        name,
+		Dir:         dir,
+		Graph:       g,
+		Entrypoints: []string{filepath.Join(dir, entrypoint)},
+		Check: &check.Config{
+			Path:      dir,
+			BlackList: map[string][]check.BlackListEntry{"a.ts": {{To: "*.ts", Reason: "a.ts is a leaf"}}},
+		},
+	}
+}
+
+func TestCompute(t *testing.T) {
+	a := require.New(t)
+	before := makeRevision(t, "main", "/before", "main.ts", [][2]string{
+		{"main.ts", "a.ts"},
+		{"main.ts", "b.ts"},
+		{"b.ts", "c.ts"},
+	})
+	after := makeRevision(t, "feature", "/after", "main.ts", [][2]string{
+		{"main.ts", "a.ts"},
+		{"main.ts", "b.ts"},
+		{"a.ts", "b.ts"},
+		{"b.ts", "d.ts"},
+		{"d.ts", "main.ts"},
+	})
+
+	result, err := Compute(before, after)
+	a.NoError(err)
+	a.Equal([]string{"d.ts"}, result.AddedFiles)
+	a.Equal([]string{"c.ts"}, result.RemovedFiles)
+	a.Equal([]Edge{
+		{From: "a.ts", To: "b.ts", Line: 1, Description: "imports foo"},
+		{From: "b.ts", To: "d.ts", Line: 1, Description: "imports foo"},
+		{From: "d.ts", To: "main.ts", Line: 1, Description: "imports foo"},
+	}, result.AddedEdges)
+	a.Equal([]Edge{{From: "b.ts", To: "c.ts", Line: 1, Description: "imports foo"}}, result.RemovedEdges)
+	a.Equal([][]string{
+		{"a.ts", "b.ts", "d.ts", "main.ts", "a.ts"},
+		{"b.ts", "d.ts", "main.ts", "b.ts"},
+	}, result.NewCycles)
+	a.Len(result.NewViolations, 1)
+	a.Equal("a.ts", result.NewViolations[0].From)
+	a.Equal("b.ts", result.NewViolations[0].To)
+
+	a.Equal(`Dependency changes from main to feature:
+
+1 file added:
++ d.ts
+
+1 file removed:
+- c.ts
+
+3 dependencies added:
++ a.ts:1 -> b.ts (imports foo)
++ b.ts:1 -> d.ts (imports foo)
++ d.ts:1 -> main.ts (imports foo)
+
+1 dependency removed:
+- b.ts:1 -> c.ts (imports foo)
+
+2 new circular dependencies:
+  a.ts -> b.ts -> d.ts -> main.ts -> a.ts
+  b.ts -> d.ts -> main.ts -> b.ts
+
+Metrics changed in 3 files:
+  a.ts: efferent 0 -> 1, instability 0.000 -> 0.500, transitive 0 -> 3
+  b.ts: afferent 1 -> 2, instability 0.500 -> 0.333, transitive 1 -> 3
+  main.ts: afferent 0 -> 1, instability 1.000 -> 0.667
+
+1 new check violation:
+- a.ts:1 -> b.ts
+  a.ts is a leaf
+`, result.Render())
+}
+
+func TestCompute_NoChanges(t *testing.T) {
+	a := require.New(t)
+	edges := [][2]string{{"main.ts", "a.ts"}}
+
+	result, err := Compute(makeRevision(t, "a", "/a", "main.ts", edges), makeRevision(t, "b", "/b", "main.ts", edges))
+	a.NoError(err)
+	a.True(result.Empty())
+	a.Equal("No changes in the dependency graph from a to b\n", result.Render())
+}
+
+func TestUnion(t *testing.T) {
+	a := require.New(t)
+	before := makeRevision(t, "main", "/before", "main.ts", [][2]string{
+		{"main.ts", "a.ts"},
+		{"a.ts", "b.ts"},
+	})
+	after := makeRevision(t, "feature", "/after", "main.ts", [][2]string{
+		{"main.ts", "a.ts"},
+		{"main.ts", "c.ts"},
+	})
+
+	g, entrypoints, status, err := Union(before, after)
+	a.NoError(err)
+	a.Equal([]string{"/after/main.ts"}, entrypoints)
+
+	var edges []string
+	for _, node := range g.AllNodes() {
+		for _, dep := range g.FromId(node.Id) {
+			edges = append(edges, node.Id+" -> "+dep.Id+" "+status(node.Id, dep.Id))
+		}
+	}
+	a.Equal([]string{
+		"/after/main.ts -> /after/a.ts ",
+		"/after/main.ts -> /after/c.ts added",
+		"/after/a.ts -> /after/b.ts removed",
+	}, edges)
+}
@@ -0,0 +1,63 @@
+package diff
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// Extract writes the files of the provided revision into dir, reading them straight from
+// the git object store, so that neither the working tree nor the index are touched.
+func Extract(repo *git.Repository, rev string, dir string) error {
+	hash, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return fmt.Errorf("could not resolve revision %s: %w", rev, err)
+	}
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return fmt.Errorf("could not read the commit of revision %s: %w", rev, err)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return fmt.Errorf("could not read the tree of revision %s: %w", rev, err)
+	}
+	return tree.Files().ForEach(func(file *object.File) error {
+		return extractFile(file, filepath.Join(dir, filepath.FromSlash(file.Name)))
+	})
+}
+
+func extractFile(file *object.File, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if file.Mode == filemode.Symlink {
+		target, err := file.Contents()
+		if err != nil {
+			return err
+		}
+		return os.Symlink(target, path)
+	}
+
+	perm := os.FileMode(0o644)
+	if file.Mode == filemode.Executable {
+		perm = 0o755
+	}
+	reader, err := file.Reader()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	out, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	_, err = io.Copy(out, reader)
+	return err
+}
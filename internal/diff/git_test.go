@@ -0,0 +1,74 @@
+package diff
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtract(t *testing.T) {
+	a := require.New(t)
+	root := t.TempDir()
+	repo, err := git.PlainInit(root, false)
+	a.NoError(err)
+	worktree, err := repo.Worktree()
+	a.NoError(err)
+
+	commit := func(files map[string]string) string {
+		for file, content := range files {
+			path := filepath.Join(root, file)
+			a.NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+			a.NoError(os.WriteFile(path, []byte(content), 0o644))
+			_, err := worktree.Add(file)
+			a.NoError(err)
+		}
+		hash, err := worktree.Commit("commit", &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@test.com", When: time.Now()},
+		})
+		a.NoError(err)
+		return hash.String()
+	}
+
+	first := commit(map[string]string{"main.py": "import foo", "src/foo.py": "foo = 1"})
+	commit(map[string]string{"src/foo.py": "foo = 2"})
+	// uncommitted changes are not extracted.
+	a.NoError(os.WriteFile(filepath.Join(root, "main.py"), []byte("dirty"), 0o644))
+
+	for _, tt := range []struct {
+		Name     string
+		Rev      string
+		Expected map[string]string
+	}{
+		{
+			Name:     "first commit",
+			Rev:      first,
+			Expected: map[string]string{"main.py": "import foo", "src/foo.py": "foo = 1"},
+		},
+		{
+			Name:     "HEAD",
+			Rev:      "HEAD",
+			Expected: map[string]string{"main.py": "import foo", "src/foo.py": "foo = 2"},
+		},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			dir := t.TempDir()
+			a.NoError(Extract(repo, tt.Rev, dir))
+			for file, expected := range tt.Expected {
+				content, err := os.ReadFile(filepath.Join(dir, file))
+				a.NoError(err)
+				a.Equal(expected, string(content))
+			}
+		})
+	}
+
+	a.ErrorContains(Extract(repo, "unknown", t.TempDir()), "could not resolve revision unknown")
+	content, err := os.ReadFile(filepath.Join(root, "main.py"))
+	a.NoError(err)
+	a.Equal("dirty", string(content))
+}
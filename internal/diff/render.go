@@ -0,0 +1,156 @@
+package diff
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/metrics"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// Render tells in a human-readable way what changed in the dependency graph.
+func (d *Diff) Render() string {
+	if d.Empty() {
+		return fmt.Sprintf("No changes in the dependency graph from %s to %s\n", d.From, d.To)
+	}
+	var sections []string
+	section := func(title string, lines []string) {
+		if len(lines) > 0 {
+			sections = append(sections, title+":\n"+strings.Join(lines, "\n")+"\n")
+		}
+	}
+
+	section(utils.Plural(len(d.AddedFiles), "file added", "files added"), prefixed("+ ", d.AddedFiles))
+	section(utils.Plural(len(d.RemovedFiles), "file removed", "files removed"), prefixed("- ", d.RemovedFiles))
+
+	added := make([]string, len(d.AddedEdges))
+	for i, edge := range d.AddedEdges {
+		added[i] = "+ " + edge.render()
+	}
+	section(utils.Plural(len(d.AddedEdges), "dependency added", "dependencies added"), added)
+	removed := make([]string, len(d.RemovedEdges))
+	for i, edge := range d.RemovedEdges {
+		removed[i] = "- " + edge.render()
+	}
+	section(utils.Plural(len(d.RemovedEdges), "dependency removed", "dependencies removed"), removed)
+
+	cycles := make([]string, len(d.NewCycles))
+	for i, cycle := range d.NewCycles {
+		cycles[i] = "  " + strings.Join(cycle, " -> ")
+	}
+	section(utils.Plural(len(d.NewCycles), "new circular dependency", "new circular dependencies"), cycles)
+
+	changes := make([]string, len(d.Metrics))
+	for i, change := range d.Metrics {
+		changes[i] = "  " + change.File + ": " + change.render()
+	}
+	section("Metrics changed in "+utils.Plural(len(d.Metrics), "file", "files"), changes)
+
+	violations := make([]string, len(d.NewViolations))
+	for i, violation := range d.NewViolations {
+		violations[i] = "- " + Edge{From: violation.From, To: violation.To, Line: violation.Data.Line()}.render()
+		if violation.Reason != "" {
+			violations[i] += "\n  " + strings.ReplaceAll(strings.TrimSpace(violation.Reason), "\n", "\n  ")
+		}
+	}
+	section(utils.Plural(len(d.NewViolations), "new check violation", "new check violations"), violations)
+
+	return fmt.Sprintf("Dependency changes from %s to %s:\n\n", d.From, d.To) + strings.Join(sections, "\n")
+}
+
+func prefixed(prefix string, lines []string) []string {
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		result[i] = prefix + line
+	}
+	return result
+}
+
+func (e Edge) render() string {
+	from := e.From
+	if e.Line > 0 {
+		from += fmt.Sprintf(":%d", e.Line)
+	}
+	result := from + " -> " + e.To
+	if e.Description != "" {
+		result += " (" + e.Description + ")"
+	}
+	return result
+}
+
+func (c MetricsChange) render() string {
+	var changes []string
+	for _, column := range metrics.Columns {
+		for _, name := range c.Changed() {
+			if column.Name == name {
+				changes = append(changes, fmt.Sprintf("%s %s -> %s", name, column.Format(c.Before), column.Format(c.After)))
+			}
+		}
+	}
+	return strings.Join(changes, ", ")
+}
+
+// Union returns a graph with the files and dependencies of both revisions, along with the
+// status of each dependency, which is Added, Removed, or empty if it did not change. Files
+// that only exist in a are placed in the directory of b, as if they were never removed.
+func Union(a, b *Revision) (*graph.Graph[*language.FileInfo], []string, func(from, to string) string, error) {
+	g := b.Graph.Clone()
+	ids := map[string]string{}
+	for _, node := range b.Graph.AllNodes() {
+		ids[b.key(node.Id)] = node.Id
+	}
+	for _, node := range a.Graph.AllNodes() {
+		key := a.key(node.Id)
+		if _, ok := ids[key]; ok {
+			continue
+		}
+		id := node.Id
+		if key != node.Id {
+			id = filepath.Join(b.Dir, filepath.FromSlash(key))
+		}
+		info := *node.Data
+		info.AbsPath = id
+		if err := g.AddNode(graph.MakeNode(id, &info)); err != nil {
+			return nil, nil, nil, err
+		}
+		ids[key] = id
+	}
+
+	edgesA, edgesB := a.edges(), b.edges()
+	for _, node := range a.Graph.AllNodes() {
+		for _, dep := range a.Graph.FromId(node.Id) {
+			from, to := a.key(node.Id), a.key(dep.Id)
+			if _, ok := edgesB[[2]string{from, to}]; ok {
+				continue
+			}
+			if err := g.AddEdge(ids[from], ids[to], a.Graph.EdgeData(node.Id, dep.Id)); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+	}
+
+	entrypoints := b.Entrypoints
+	if len(entrypoints) == 0 {
+		for _, entrypoint := range a.Entrypoints {
+			entrypoints = append(entrypoints, ids[a.key(entrypoint)])
+		}
+	}
+
+	status := func(from, to string) string {
+		key := [2]string{b.key(from), b.key(to)}
+		_, inA := edgesA[key]
+		_, inB := edgesB[key]
+		switch {
+		case inA && !inB:
+			return Removed
+		case inB && !inA:
+			return Added
+		default:
+			return ""
+		}
+	}
+	return g, entrypoints, status, nil
+}
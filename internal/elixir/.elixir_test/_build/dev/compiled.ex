@@ -0,0 +1,2 @@
+defmodule Core.Accounts.User do
+end
@@ -0,0 +1,5 @@
+defmodule Core do
+  @moduledoc """
+  Core business logic.
+  """
+end
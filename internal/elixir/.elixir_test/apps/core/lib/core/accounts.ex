@@ -0,0 +1,10 @@
+defmodule Core.Accounts do
+  alias Core.Repo
+  alias Core.Accounts.{User, Token}
+
+  def get(id), do: Repo.get(User, id)
+
+  def sign(%User{} = user) do
+    Token.sign(user)
+  end
+end
@@ -0,0 +1,3 @@
+defmodule Core.Accounts.Token do
+  def sign(user), do: :crypto.hash(:sha256, user.name)
+end
@@ -0,0 +1,11 @@
+defmodule Core.Accounts.User do
+  defstruct [:id, :name, :settings]
+
+  defmodule Settings do
+    defstruct theme: :light
+
+    def default, do: %__MODULE__{}
+  end
+
+  def new(name), do: %__MODULE__{name: name, settings: Settings.default()}
+end
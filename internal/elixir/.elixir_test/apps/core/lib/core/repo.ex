@@ -0,0 +1,3 @@
+defmodule Core.Repo do
+  use Ecto.Repo, otp_app: :core
+end
@@ -0,0 +1,7 @@
+defmodule Core.MixProject do
+  use Mix.Project
+
+  def project do
+    [app: :core, version: "0.1.0", build_path: "../../_build"]
+  end
+end
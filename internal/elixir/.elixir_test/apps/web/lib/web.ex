@@ -0,0 +1,11 @@
+defmodule Web do
+  def controller do
+    quote do
+      import Web.Helpers
+    end
+  end
+
+  defmacro __using__(which) when is_atom(which) do
+    apply(__MODULE__, which, [])
+  end
+end
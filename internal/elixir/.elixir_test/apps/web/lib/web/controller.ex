@@ -0,0 +1,12 @@
+defmodule Web.Controller do
+  use Web, :controller
+  require Logger
+  alias Core.Accounts, as: Acc
+
+  def show(id) do
+    Logger.info("showing #{id}")
+    user = Acc.get(id)
+    settings = Core.Accounts.User.Settings.default()
+    render({user, settings})
+  end
+end
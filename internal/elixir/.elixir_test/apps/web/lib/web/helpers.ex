@@ -0,0 +1,3 @@
+defmodule Web.Helpers do
+  def render(data), do: inspect(data)
+end
@@ -0,0 +1,7 @@
+defmodule Web.MixProject do
+  use Mix.Project
+
+  def project do
+    [app: :web, version: "0.1.0", deps: [{:core, in_umbrella: true}]]
+  end
+end
@@ -0,0 +1,8 @@
+defmodule Web.ControllerTest do
+  use ExUnit.Case
+  alias Web.Controller
+
+  test "show" do
+    assert Controller.show(1)
+  end
+end
@@ -0,0 +1,2 @@
+defmodule Ecto.Repo do
+end
@@ -0,0 +1,3 @@
+package elixir
+
+type Config struct{}
@@ -0,0 +1,77 @@
+//nolint:govet
+package elixir_grammar
+
+import (
+	"bytes"
+	"os"
+	"strings"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+type Statement struct {
+	Pos       lexer.Position
+	Defmodule *Defmodule `  @@`
+	Alias     *Alias     `| @@`
+	Reference *Reference `| @@`
+	// Block swallows any do ... end or fn ... end block that was not matched
+	// by the statements above, so that they are always balanced.
+	Block *Block `| @@`
+}
+
+type Block struct {
+	Statements []*Statement `("do" | "fn") (@@ | ~("do" | "end" | "fn"))* "end"`
+}
+
+type File struct {
+	Statements []*Statement `(@@ | ~("do" | "fn"))*`
+}
+
+const sigilDelimiters = `(?:"""(?:.|\n)*?"""|'''(?:.|\n)*?'''|"(?:\\.|[^"\\])*"|'(?:\\.|[^'\\])*'|\((?:\\.|[^)\\])*\)|\[(?:\\.|[^\]\\])*\]|\{(?:\\.|[^}\\])*\}|<(?:\\.|[^>\\])*>|/(?:\\.|[^/\\])*/|\|(?:\\.|[^|\\])*\|)`
+
+var (
+	lex = lexer.MustSimple(
+		[]lexer.SimpleRule{
+			{"Comment", `#.*`},
+			{"Heredoc", `"""(?:.|\n)*?"""|'''(?:.|\n)*?'''`},
+			{"Sigil", `~[a-zA-Z]+` + sigilDelimiters + `[a-zA-Z]*`},
+			{"String", `"(?:\\.|#\{[^}]*\}|[^"\\])*"|'(?:\\.|[^'\\])*'`},
+			{"Char", `\?(?:\\.|[^\s])`},
+			{"Atom", `:(?:"(?:\\.|[^"\\])*"|[a-zA-Z_][a-zA-Z0-9_]*[?!]?)`},
+			{"Keyword", `[a-zA-Z_][a-zA-Z0-9_]*[?!]?:\s`},
+			{"Module", `[A-Z][a-zA-Z0-9_]*(?:\.[A-Z][a-zA-Z0-9_]*)*`},
+			{"Ident", `[a-z_][a-zA-Z0-9_]*[?!]?`},
+			{"Number", `[0-9][0-9_]*(?:\.[0-9_]+)?`},
+			{"Punct", `[^\sa-zA-Z0-9_]`},
+			{"Whitespace", `\s+`},
+		},
+	)
+	parser = participle.MustBuild[File](
+		participle.Lexer(lex),
+		participle.Elide("Whitespace", "Comment"),
+		participle.Map(func(token lexer.Token) (lexer.Token, error) {
+			token.Value = strings.TrimSpace(token.Value)
+			return token, nil
+		}, "Keyword"),
+		participle.UseLookahead(1024),
+	)
+)
+
+func Parse(filePath string) (*language.FileInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseBytes(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	return &language.FileInfo{
+		Content: file,
+		Loc:     bytes.Count(content, []byte("\n")),
+		Size:    len(content),
+		AbsPath: filePath,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package elixir_grammar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		Name               string
+		ExpectedModules    []string
+		ExpectedAliases    []Alias
+		ExpectedReferences []string
+	}{
+		{
+			Name:               "defmodule MyApp.Accounts do\n  def get(id), do: Repo.get(User, id)\nend",
+			ExpectedModules:    []string{"MyApp.Accounts"},
+			ExpectedReferences: []string{"Repo", "User"},
+		},
+		{
+			Name: "alias MyApp.Repo\nalias MyApp.Accounts, as: Acc\nalias MyApp.Accounts.{User, Token,}",
+			ExpectedAliases: []Alias{
+				{Module: "MyApp.Repo"},
+				{Module: "MyApp.Accounts", As: "Acc"},
+				{Module: "MyApp.Accounts", Multi: []string{"User", "Token"}},
+			},
+		},
+		{
+			Name:               "defmodule A do\n  import B\n  require Logger\n  use C, :controller\n  defmodule Nested do\n    def f, do: fn x -> D.E.f(x) end\n  end\n  def g do\n    case x do\n      %F{} -> __MODULE__.Nested.f()\n    end\n  end\nend\ndefprotocol P do\nend",
+			ExpectedModules:    []string{"A", "A.Nested", "P"},
+			ExpectedReferences: []string{"B", "Logger", "C", "D.E", "F", "__MODULE__.Nested"},
+		},
+		{
+			Name:               "# defmodule Commented do end\n@moduledoc \"\"\"\ndefmodule InDoc do end\n\"\"\"\ns = ~s(defmodule InSigil do end)\nr = ~r/end/i\nc = ?e\nm = %{do: 1, end: 2}\nk = :end\nG.h()",
+			ExpectedReferences: []string{"G"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			parsed, err := parser.ParseBytes("", []byte(tt.Name))
+			a.NoError(err)
+
+			var modules []string
+			var aliases []Alias
+			var references []string
+			var walk func(stmts []*Statement, prefix string)
+			walk = func(stmts []*Statement, prefix string) {
+				for _, stmt := range stmts {
+					switch {
+					case stmt.Defmodule != nil:
+						modules = append(modules, prefix+stmt.Defmodule.Name)
+						walk(stmt.Defmodule.Statements, prefix+stmt.Defmodule.Name+".")
+					case stmt.Alias != nil:
+						aliases = append(aliases, *stmt.Alias)
+					case stmt.Reference != nil:
+						ref := stmt.Reference.Module
+						if stmt.Reference.Current {
+							ref = "__MODULE__." + ref
+						}
+						references = append(references, ref)
+					case stmt.Block != nil:
+						walk(stmt.Block.Statements, prefix)
+					}
+				}
+			}
+			walk(parsed.Statements, "")
+			a.Equal(tt.ExpectedModules, modules)
+			a.Equal(tt.ExpectedAliases, aliases)
+			a.Equal(tt.ExpectedReferences, references)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+//nolint:govet
+package elixir_grammar
+
+// Defmodule is a module definition, e.g. defmodule MyApp.Accounts do ... end.
+// Protocols are modules too.
+type Defmodule struct {
+	Name       string       `("defmodule" | "defprotocol") @Module "do"`
+	Statements []*Statement `(@@ | ~("do" | "end" | "fn"))* "end"`
+}
+
+// Alias is an alias directive, in any of its forms:
+//
+//	alias MyApp.Accounts
+//	alias MyApp.Accounts, as: Acc
+//	alias MyApp.Accounts.{User, Token}
+type Alias struct {
+	Module string   `"alias" @Module`
+	Multi  []string `("." "{" @Module ("," @Module)* ","? "}")?`
+	As     string   `("," "as:" @Module)?`
+}
+
+// Reference is any usage of a module name, like the module in a remote call,
+// a struct or the target of an import, use or require directive.
+type Reference struct {
+	Current bool   `(@"__MODULE__" ".")?`
+	Module  string `@Module`
+}
@@ -0,0 +1,26 @@
+package elixir
+
+import (
+	"github.com/gabotechs/dep-tree/internal/elixir/elixir_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	exports := make([]language.ExportEntry, 0)
+
+	content := file.Content.(*elixir_grammar.File)
+	var symbols []language.ExportSymbol
+	for _, module := range definedModules(content.Statements, "") {
+		symbols = append(symbols, language.ExportSymbol{Original: module})
+	}
+	if len(symbols) > 0 {
+		exports = append(exports, language.ExportEntry{
+			Symbols: symbols,
+			AbsPath: file.AbsPath,
+		})
+	}
+
+	return &language.ExportsResult{
+		Exports: exports,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package elixir
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func TestLanguage_ParseExports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+	user := filepath.Join(absTestFolder, "apps", "core", "lib", "core", "accounts", "user.ex")
+
+	a := require.New(t)
+	lang, err := MakeElixirLanguage(nil)
+	a.NoError(err)
+
+	file, err := lang.ParseFile(user)
+	a.NoError(err)
+
+	exports, err := lang.ParseExports(file)
+	a.NoError(err)
+	a.Equal([]language.ExportEntry{{
+		Symbols: []language.ExportSymbol{
+			{Original: "Core.Accounts.User"},
+			{Original: "Core.Accounts.User.Settings"},
+		},
+		AbsPath: user,
+	}}, exports.Exports)
+}
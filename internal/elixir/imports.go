@@ -0,0 +1,128 @@
+package elixir
+
+import (
+	"strings"
+
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/elliotchance/orderedmap/v2"
+
+	"github.com/gabotechs/dep-tree/internal/elixir/elixir_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+// scope holds the aliases available at some point of a file, mapping the short
+// name to the full module name.
+type scope map[string]string
+
+func (s scope) with(name string, module string) scope {
+	result := make(scope, len(s)+1)
+	for k, v := range s {
+		result[k] = v
+	}
+	result[name] = module
+	return result
+}
+
+// expand returns the full name of a referenced module, expanding its first
+// segment if it is an alias.
+func (s scope) expand(module string) string {
+	first, rest, hasRest := strings.Cut(module, ".")
+	if full, ok := s[first]; ok {
+		if hasRest {
+			return full + "." + rest
+		}
+		return full
+	}
+	return module
+}
+
+// reference is a module referenced at some position of a file.
+type reference struct {
+	module string
+	pos    lexer.Position
+}
+
+func lastSegment(module string) string {
+	return module[strings.LastIndex(module, ".")+1:]
+}
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	result := language.ImportsResult{Imports: make([]language.ImportEntry, 0)}
+
+	index, err := modulesIndex(indexRoot(file.AbsPath))
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Gather every module referenced in the file, expanding aliases based on
+	//    the lexical scope where they are referenced.
+	content := file.Content.(*elixir_grammar.File)
+	var referenced []reference
+	var walk func(stmts []*elixir_grammar.Statement, current string, s scope)
+	walk = func(stmts []*elixir_grammar.Statement, current string, s scope) {
+		for _, stmt := range stmts {
+			switch {
+			case stmt.Defmodule != nil:
+				name := nestedName(current, stmt.Defmodule.Name)
+				// Nested modules are automatically aliased in the parent module.
+				if current != "" {
+					s = s.with(lastSegment(stmt.Defmodule.Name), name)
+				}
+				walk(stmt.Defmodule.Statements, name, s)
+			case stmt.Alias != nil:
+				module := s.expand(stmt.Alias.Module)
+				switch {
+				case len(stmt.Alias.Multi) > 0:
+					for _, child := range stmt.Alias.Multi {
+						s = s.with(lastSegment(child), module+"."+child)
+						referenced = append(referenced, reference{module + "." + child, stmt.Pos})
+					}
+				case stmt.Alias.As != "":
+					s = s.with(stmt.Alias.As, module)
+					referenced = append(referenced, reference{module, stmt.Pos})
+				default:
+					s = s.with(lastSegment(module), module)
+					referenced = append(referenced, reference{module, stmt.Pos})
+				}
+			case stmt.Reference != nil:
+				if stmt.Reference.Current {
+					referenced = append(referenced, reference{current + "." + stmt.Reference.Module, stmt.Pos})
+				} else {
+					referenced = append(referenced, reference{s.expand(stmt.Reference.Module), stmt.Pos})
+				}
+			case stmt.Block != nil:
+				walk(stmt.Block.Statements, current, s)
+			}
+		}
+	}
+	walk(content.Statements, "", scope{})
+
+	// 2. Match the referenced modules with the ones in the project's index, the
+	//    rest are either from the standard library or from dependencies. Imports
+	//    are placed where the first module of each file is referenced.
+	modulesByFile := orderedmap.NewOrderedMap[string, []string]()
+	positions := map[string]lexer.Position{}
+	seen := map[string]bool{}
+	for _, ref := range referenced {
+		if seen[ref.module] {
+			continue
+		}
+		seen[ref.module] = true
+		absPath, ok := index[ref.module]
+		if !ok || absPath == file.AbsPath {
+			continue
+		}
+		modules, ok := modulesByFile.Get(absPath)
+		if !ok {
+			positions[absPath] = ref.pos
+		}
+		modulesByFile.Set(absPath, append(modules, ref.module))
+	}
+	for el := modulesByFile.Front(); el != nil; el = el.Next() {
+		entry := language.SymbolsImport(el.Value, el.Key)
+		entry.Line, entry.Column = positions[el.Key].Line, positions[el.Key].Column
+		result.Imports = append(result.Imports, entry)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,83 @@
+package elixir
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".elixir_test"
+
+func TestLanguage_ParseImports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+	core := filepath.Join(absTestFolder, "apps", "core", "lib")
+	web := filepath.Join(absTestFolder, "apps", "web", "lib")
+
+	tests := []struct {
+		Name     string
+		File     string
+		Expected []language.ImportEntry
+	}{
+		{
+			Name: "alias directives",
+			File: filepath.Join(core, "core", "accounts.ex"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"Core.Repo"}, filepath.Join(core, "core", "repo.ex")), 2, 3),
+				at(language.SymbolsImport([]string{"Core.Accounts.User"}, filepath.Join(core, "core", "accounts", "user.ex")), 3, 3),
+				at(language.SymbolsImport([]string{"Core.Accounts.Token"}, filepath.Join(core, "core", "accounts", "token.ex")), 3, 3),
+			},
+		},
+		{
+			Name: "use, require and remote calls across umbrella apps",
+			File: filepath.Join(web, "web", "controller.ex"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"Web"}, filepath.Join(web, "web.ex")), 2, 7),
+				at(language.SymbolsImport([]string{"Core.Accounts"}, filepath.Join(core, "core", "accounts.ex")), 4, 3),
+				at(language.SymbolsImport([]string{"Core.Accounts.User.Settings"}, filepath.Join(core, "core", "accounts", "user.ex")), 9, 16),
+			},
+		},
+		{
+			Name: "imports inside quote blocks",
+			File: filepath.Join(web, "web.ex"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"Web.Helpers"}, filepath.Join(web, "web", "helpers.ex")), 4, 14),
+			},
+		},
+		{
+			Name:     "nested modules and dependencies",
+			File:     filepath.Join(core, "core", "accounts", "user.ex"),
+			Expected: []language.ImportEntry{},
+		},
+		{
+			Name: "test files",
+			File: filepath.Join(absTestFolder, "apps", "web", "test", "web", "controller_test.exs"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"Web.Controller"}, filepath.Join(web, "web", "controller.ex")), 3, 3),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeElixirLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			imports, err := lang.ParseImports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, imports.Imports)
+			a.Nil(imports.Errors)
+		})
+	}
+}
+
+func at(entry language.ImportEntry, line, column int) language.ImportEntry {
+	entry.Line, entry.Column = line, column
+	return entry
+}
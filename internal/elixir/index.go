@@ -0,0 +1,76 @@
+package elixir
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/elixir/elixir_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// ignoredDirs are not indexed, as they contain dependencies or build artifacts.
+var ignoredDirs = []string{
+	"_build",
+	"deps",
+	"node_modules",
+}
+
+// _modulesIndex maps the name of every module defined in the project under the
+// provided root to the absolute path of the file that defines it.
+func _modulesIndex(root string) (map[string]string, error) {
+	index := make(map[string]string)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && (strings.HasPrefix(d.Name(), ".") || utils.InArray(d.Name(), ignoredDirs)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !utils.EndsWith(path, Extensions) {
+			return nil
+		}
+		file, err := parseElixirFile(path)
+		if err != nil {
+			// Files that cannot be parsed are reported when they are loaded
+			// in the graph, they should not prevent others from being indexed.
+			return nil
+		}
+		for _, module := range definedModules(file.Content.(*elixir_grammar.File).Statements, "") {
+			if _, ok := index[module]; !ok {
+				index[module] = path
+			}
+		}
+		return nil
+	})
+	return index, err
+}
+
+var modulesIndex, evictModulesIndex = utils.EvictableCached1In1OutErr(_modulesIndex)
+
+// definedModules returns the full names of the modules defined in the
+// statements, including nested ones.
+func definedModules(stmts []*elixir_grammar.Statement, parent string) []string {
+	var result []string
+	for _, stmt := range stmts {
+		switch {
+		case stmt.Defmodule != nil:
+			name := nestedName(parent, stmt.Defmodule.Name)
+			result = append(result, name)
+			result = append(result, definedModules(stmt.Defmodule.Statements, name)...)
+		case stmt.Block != nil:
+			result = append(result, definedModules(stmt.Block.Statements, parent)...)
+		}
+	}
+	return result
+}
+
+func nestedName(parent string, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
@@ -0,0 +1,52 @@
+package elixir
+
+import (
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/elixir/elixir_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var Extensions = []string{
+	"ex",
+	"exs",
+}
+
+type Language struct {
+	cfg *Config
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+
+func MakeElixirLanguage(cfg *Config) (language.Language, error) {
+	lang := Language{
+		cfg: cfg,
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+	return &lang, nil
+}
+
+var parseElixirFile, evictElixirFile = utils.EvictableCached1In1OutErr(elixir_grammar.Parse)
+
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	parsed, err := parseElixirFile(id)
+	if err != nil {
+		return nil, err
+	}
+	file := *parsed
+	file.RelPath, _ = filepath.Rel(indexRoot(id), id)
+	if project := findMixProject(filepath.Dir(id)); project != nil {
+		file.Package = readAppName(project.AbsDir)
+	}
+	return &file, nil
+}
+
+// Invalidate drops the provided files and the module index of the projects containing them.
+func (l *Language) Invalidate(absPaths ...string) {
+	evictElixirFile(utils.IsAnyOf(absPaths))
+	evictModulesIndex(utils.ContainsAnyOf(absPaths))
+}
@@ -0,0 +1,43 @@
+package elixir
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguage_ParseFile(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Path            string
+		ExpectedRelPath string
+		ExpectedPackage string
+	}{
+		{
+			Name:            "umbrella app",
+			Path:            filepath.Join(testFolder, "apps", "web", "lib", "web.ex"),
+			ExpectedRelPath: "apps/web/lib/web.ex",
+			ExpectedPackage: "web",
+		},
+		{
+			Name:            "umbrella root",
+			Path:            filepath.Join(testFolder, "mix.exs"),
+			ExpectedRelPath: "mix.exs",
+			ExpectedPackage: ".elixir_test",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeElixirLanguage(nil)
+			a.NoError(err)
+			absPath, _ := filepath.Abs(tt.Path)
+			file, err := lang.ParseFile(absPath)
+			a.NoError(err)
+			a.Equal(tt.ExpectedPackage, file.Package)
+			a.Equal(tt.ExpectedRelPath, file.RelPath)
+		})
+	}
+}
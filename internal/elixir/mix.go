@@ -0,0 +1,45 @@
+package elixir
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var findMixProject = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	"mix.exs",
+})
+
+var appRegex = regexp.MustCompile(`app:\s*:([a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// _readAppName returns the name of the OTP application declared in the mix.exs
+// of the provided dir, falling back to the dir's name.
+func _readAppName(dir string) string {
+	content, err := os.ReadFile(filepath.Join(dir, "mix.exs"))
+	if err == nil {
+		if match := appRegex.FindSubmatch(content); match != nil {
+			return string(match[1])
+		}
+	}
+	return filepath.Base(dir)
+}
+
+var readAppName = utils.Cached1In1Out(_readAppName)
+
+// indexRoot returns the dir from which modules are indexed for the provided
+// file. Apps in an umbrella project (apps/*/mix.exs) share the umbrella's root,
+// so that they can reference each other's modules.
+func indexRoot(absPath string) string {
+	project := findMixProject(filepath.Dir(absPath))
+	if project == nil {
+		return filepath.Dir(absPath)
+	}
+	appsDir := filepath.Dir(project.AbsDir)
+	umbrellaDir := filepath.Dir(appsDir)
+	if filepath.Base(appsDir) == "apps" && utils.FileExists(filepath.Join(umbrellaDir, "mix.exs")) {
+		return umbrellaDir
+	}
+	return project.AbsDir
+}
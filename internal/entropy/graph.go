@@ -30,6 +30,12 @@ type Link struct {
 	From     int64 `json:"from"`
 	To       int64 `json:"to"`
 	IsCyclic bool  `json:"isCyclic"`
+	// Weight is the amount of dependencies between files that the link stands for
+	// when files are collapsed into directories or packages.
+	Weight int `json:"weight,omitempty"`
+	// Diff is "added" or "removed" when rendering the changes between two revisions,
+	// and empty if the link did not change.
+	Diff string `json:"diff,omitempty"`
 }
 
 type Graph struct {
@@ -44,6 +50,13 @@ func makeGraph(files []string, parser graph.NodeParser[*language.FileInfo], load
 	if err != nil {
 		return Graph{}, err
 	}
+	return toGraph3d(g, files)
+}
+
+// toGraph3d converts an already loaded graph to the format rendered in the browser.
+// The graph is not modified.
+func toGraph3d(original *graph.Graph[*language.FileInfo], files []string) (Graph, error) {
+	g := original.Clone()
 	var singleEntrypointAbsPath string
 	var entrypoints []*graph.Node[*language.FileInfo]
 	if len(files) == 1 {
@@ -84,8 +97,9 @@ func makeGraph(files []string, parser graph.NodeParser[*language.FileInfo], load
 
 		for _, to := range g.FromId(node.Id) {
 			out.Links = append(out.Links, Link{
-				From: node.ID(),
-				To:   to.ID(),
+				From:   node.ID(),
+				To:     to.ID(),
+				Weight: linkWeight(original, node.Id, to.Id),
 			})
 		}
 	}
@@ -95,8 +109,16 @@ func makeGraph(files []string, parser graph.NodeParser[*language.FileInfo], load
 			From:     graph.MakeNode(cycle.Cause[0], 0).ID(),
 			To:       graph.MakeNode(cycle.Cause[1], 0).ID(),
 			IsCyclic: true,
+			Weight:   linkWeight(original, cycle.Cause[0], cycle.Cause[1]),
 		})
 	}
 
 	return out, nil
 }
+
+func linkWeight(g *graph.Graph[*language.FileInfo], from, to string) int {
+	if data := g.EdgeData(from, to); data != nil {
+		return data.Weight
+	}
+	return 0
+}
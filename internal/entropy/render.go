@@ -30,6 +30,36 @@ func Render(files []string, parser graph.NodeParser[*language.FileInfo], cfg Ren
 	if err != nil {
 		return err
 	}
+	return render(graph3d, cfg)
+}
+
+// RenderGraph renders an already loaded graph, without modifying it.
+func RenderGraph(g *graph.Graph[*language.FileInfo], files []string, cfg RenderConfig) error {
+	graph3d, err := toGraph3d(g, files)
+	if err != nil {
+		return err
+	}
+	return render(graph3d, cfg)
+}
+
+// RenderDiff renders an already loaded graph, where each link is tagged with the status
+// returned by diff, so that added and removed links are colored differently.
+func RenderDiff(g *graph.Graph[*language.FileInfo], files []string, diff func(from, to string) string, cfg RenderConfig) error {
+	graph3d, err := toGraph3d(g, files)
+	if err != nil {
+		return err
+	}
+	ids := map[int64]string{}
+	for _, node := range g.AllNodes() {
+		ids[node.ID()] = node.Id
+	}
+	for i, link := range graph3d.Links {
+		graph3d.Links[i].Diff = diff(ids[link.From], ids[link.To])
+	}
+	return render(graph3d, cfg)
+}
+
+func render(graph3d Graph, cfg RenderConfig) error {
 	graph3d.EnableGui = cfg.EnableGui
 	marshaled, err := json.Marshal(graph3d)
 	if err != nil {
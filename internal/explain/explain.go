@@ -5,12 +5,20 @@ import (
 	"github.com/gabotechs/dep-tree/internal/utils"
 )
 
+// Dependency is a direct dependency from one of the files at the left to one at the right.
+type Dependency[T any] struct {
+	From *graph.Node[T]
+	To   *graph.Node[T]
+	// Data tells why From depends on To, it might be nil if it's not known.
+	Data *graph.EdgeData
+}
+
 func Explain[T any](
 	parser graph.NodeParser[T],
 	fromFiles []string,
 	toFiles []string,
 	callbacks graph.LoadCallbacks[T],
-) ([][2]*graph.Node[T], error) {
+) ([]Dependency[T], error) {
 	// 1. Build the graph.
 	g := graph.NewGraph[T]()
 	err := g.Load(append(fromFiles, toFiles...), parser, callbacks)
@@ -22,13 +30,17 @@ func Explain[T any](
 	fromSet := utils.SetFromSlice(fromFiles)
 
 	nodes := g.AllNodes()
-	var deps [][2]*graph.Node[T]
+	var deps []Dependency[T]
 	for _, node := range nodes {
 		if fromSet.Has(node.Id) {
 			for _, toFile := range toFiles {
 				toNode := g.Get(toFile)
 				if toNode != nil && g.HasEdgeFromTo(node.ID(), toNode.ID()) {
-					deps = append(deps, [2]*graph.Node[T]{node, toNode})
+					deps = append(deps, Dependency[T]{
+						From: node,
+						To:   toNode,
+						Data: g.EdgeData(node.Id, toNode.Id),
+					})
 				}
 			}
 		}
@@ -1,7 +1,7 @@
 package golang
 
 import (
-	"strings"
+	"go/ast"
 
 	"github.com/gabotechs/dep-tree/internal/language"
 )
@@ -10,17 +10,16 @@ func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResul
 	content := file.Content.(*File)
 	results := language.ExportsResult{}
 	for symbol := range content.AstFile.Scope.Objects {
-		if len(symbol) == 0 {
+		// only identifiers starting with an upper case letter are exported, so `_foo` is not.
+		if !ast.IsExported(symbol) {
 			continue
 		}
-		if symbol[:1] == strings.ToUpper(symbol[:1]) {
-			results.Exports = append(results.Exports, language.ExportEntry{
-				Symbols: []language.ExportSymbol{{
-					Original: symbol,
-				}},
-				AbsPath: file.AbsPath,
-			})
-		}
+		results.Exports = append(results.Exports, language.ExportEntry{
+			Symbols: []language.ExportSymbol{{
+				Original: symbol,
+			}},
+			AbsPath: file.AbsPath,
+		})
 	}
 	return &results, nil
 }
@@ -2,6 +2,7 @@ package golang
 
 import (
 	"go/ast"
+	"go/token"
 	"path/filepath"
 	"strings"
 
@@ -16,6 +17,7 @@ func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResul
 	// 1. Load all the local packages imported by the file that are not
 	//    third party libraries, and that in fact are part of the codebase.
 	importedPackages := make(map[string][]*Package)
+	importPositions := make(map[string]token.Pos)
 	thisModule := l.GoMod.Module + "/"
 	for _, importSpec := range content.AstFile.Imports {
 		importStmt := NewImportStmt(importSpec)
@@ -34,6 +36,7 @@ func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResul
 				name = importStmt.ImportName
 			}
 			importedPackages[name] = append(importedPackages[name], pkg)
+			importPositions[name] = importSpec.Pos()
 		}
 	}
 
@@ -56,7 +59,8 @@ func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResul
 
 		for _, pkg := range pkgLookup {
 			if f, ok := pkg.SymbolToFile[unresolved.Name]; ok {
-				result.Imports = append(result.Imports, language.SymbolsImport([]string{unresolved.Name}, f.AbsPath))
+				entry := language.SymbolsImport([]string{unresolved.Name}, f.AbsPath)
+				result.Imports = append(result.Imports, content.at(entry, unresolved.Pos()))
 				nonQualifiedResolutions[unresolved.Name] = struct{}{}
 				break
 			}
@@ -106,10 +110,9 @@ func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResul
 				return true
 			}
 
-			result.Imports = append(result.Imports, language.SymbolsImport(
-				[]string{selectorExpr.Sel.Name},
-				absPath,
-			))
+			// references to imported packages point to the import statement that brought them.
+			entry := language.SymbolsImport([]string{selectorExpr.Sel.Name}, absPath)
+			result.Imports = append(result.Imports, content.at(entry, importPositions[libAlias.Name]))
 			fullyQualifiedResolutions[key] = struct{}{}
 			return true
 		})
@@ -118,6 +121,16 @@ func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResul
 	return &result, nil
 }
 
+// at places entry at the line and column of pos within the file.
+func (f *File) at(entry language.ImportEntry, pos token.Pos) language.ImportEntry {
+	if f.TokenFile == nil || !pos.IsValid() {
+		return entry
+	}
+	position := f.TokenFile.Position(pos)
+	entry.Line, entry.Column = position.Line, position.Column
+	return entry
+}
+
 type ImportStmt struct {
 	ImportPath string
 	ImportName string
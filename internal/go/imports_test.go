@@ -26,6 +26,7 @@ func TestImports(t *testing.T) {
 				{"PackagesInDir", "internal/go/package.go"},
 				{"Language", "internal/go/language.go"},
 				{"ImportsResult", "internal/language/language.go"},
+				{"ImportEntry", "internal/language/language.go"},
 				{"FileInfo", "internal/language/language.go"},
 				{"File", "internal/go/package.go"},
 			},
@@ -44,7 +45,7 @@ func TestImports(t *testing.T) {
 		{
 			Name: "package.go",
 			Expected: [][2]string{
-				{"Cached1In1OutErr", "internal/utils/cached.go"},
+				{"EvictableCached1In1OutErr", "internal/utils/cached.go"},
 			},
 		},
 		{
@@ -71,6 +72,8 @@ func TestImports(t *testing.T) {
 			var actual [][2]string
 			for _, imp := range imports.Imports {
 				a.Equal(1, len(imp.Symbols))
+				a.Positive(imp.Line)
+				a.Positive(imp.Column)
 				actual = append(actual, [2]string{imp.Symbols[0], imp.AbsPath})
 			}
 
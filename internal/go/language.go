@@ -3,7 +3,9 @@ package golang
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
 	"path/filepath"
+	"strings"
 
 	"github.com/gabotechs/dep-tree/internal/language"
 	"github.com/gabotechs/dep-tree/internal/utils"
@@ -35,16 +37,14 @@ func NewLanguage(dir string, cfg *Config) (*Language, error) {
 	}
 
 	return &Language{
-		Cfg:   cfg,
-		GoMod: *goMod,
-		Root:  *sourcesRoot,
+		Cfg:      cfg,
+		GoMod:    *goMod,
+		Root:     *sourcesRoot,
+		Packages: make(map[string]*ast.Package),
 	}, nil
 }
 
 func (l *Language) ParseFile(path string) (*language.FileInfo, error) {
-	if l.Packages == nil {
-		l.Packages = make(map[string]*ast.Package)
-	}
 	absPath, err := filepath.Abs(path)
 	if err != nil {
 		return nil, err
@@ -57,17 +57,60 @@ func (l *Language) ParseFile(path string) (*language.FileInfo, error) {
 
 	relPath, _ := filepath.Rel(l.Root.AbsDir, absPath)
 
+	types, abstractTypes := countTypes(file.AstFile)
+
+	// when collapsing files into packages, they are identified by their import path, as
+	// different packages can have the same name. External test packages live in the same
+	// dir as the package they test.
+	importPath := l.GoMod.Module
+	if relDir := filepath.Dir(relPath); relDir != "." {
+		importPath += "/" + filepath.ToSlash(relDir)
+	}
+	if strings.HasSuffix(file.Package.Name, "_test") {
+		importPath += "_test"
+	}
+
 	return &language.FileInfo{
-		Content: file,
-		AbsPath: absPath,
-		RelPath: relPath,
-		Package: file.Package.Name,
-		Size:    file.TokenFile.Size(),
-		Loc:     file.TokenFile.LineCount(),
+		Content:       file,
+		AbsPath:       absPath,
+		RelPath:       relPath,
+		Package:       file.Package.Name,
+		PackageId:     importPath,
+		Size:          file.TokenFile.Size(),
+		Loc:           file.TokenFile.LineCount(),
+		Types:         types,
+		AbstractTypes: abstractTypes,
 	}, nil
 }
 
+// countTypes returns how many types are declared at the top level of the file, and how
+// many of them are interfaces.
+func countTypes(file *ast.File) (int, int) {
+	types, interfaces := 0, 0
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			types++
+			if _, ok := spec.(*ast.TypeSpec).Type.(*ast.InterfaceType); ok {
+				interfaces++
+			}
+		}
+	}
+	return types, interfaces
+}
+
 var findClosestDirWithRootFile = utils.MakeCachedFindClosestDirWithRootFile([]string{
 	// NOTE: for now, only support projects that contain a go.mod file.
 	"go.mod",
 })
+
+// Invalidate drops the packages where the provided files live, and all the files in
+// them, as symbols might have moved from one file of the package to another.
+func (l *Language) Invalidate(absPaths ...string) {
+	isDirOf := utils.IsDirOfAnyOf(absPaths)
+	evictPackagesInDir(isDirOf)
+	evictFile(func(path string) bool { return isDirOf(filepath.Dir(path)) })
+}
@@ -1,32 +1,45 @@
 package golang
 
 import (
+	"go/parser"
+	"go/token"
+	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
 )
 
 func TestParseFile(t *testing.T) {
 	absPath, _ := filepath.Abs(".")
 
 	tests := []struct {
-		Name    string
-		File    string
-		RelPath string
-		AbsPath string
+		Name      string
+		File      string
+		RelPath   string
+		AbsPath   string
+		Package   string
+		PackageId string
 	}{
 		{
-			Name:    "Can lookup a path given it's relative path",
-			File:    "./language.go",
-			RelPath: "internal/go/language.go",
-			AbsPath: filepath.Join(absPath, "language.go"),
+			Name:      "Can lookup a path given it's relative path",
+			File:      "./language.go",
+			RelPath:   "internal/go/language.go",
+			AbsPath:   filepath.Join(absPath, "language.go"),
+			Package:   "golang",
+			PackageId: "github.com/gabotechs/dep-tree/internal/go",
 		},
 		{
-			Name:    "Can lookup a path given it's absolute path",
-			File:    filepath.Join(absPath, "language.go"),
-			RelPath: "internal/go/language.go",
-			AbsPath: filepath.Join(absPath, "language.go"),
+			Name:      "Can lookup a path given it's absolute path",
+			File:      filepath.Join(absPath, "language.go"),
+			RelPath:   "internal/go/language.go",
+			AbsPath:   filepath.Join(absPath, "language.go"),
+			Package:   "golang",
+			PackageId: "github.com/gabotechs/dep-tree/internal/go",
 		},
 	}
 
@@ -39,6 +52,65 @@ func TestParseFile(t *testing.T) {
 			a.NoError(err)
 			a.Equal(tt.RelPath, file.RelPath)
 			a.Equal(tt.AbsPath, file.AbsPath)
+			a.Equal(tt.Package, file.Package)
+			a.Equal(tt.PackageId, file.PackageId)
 		})
 	}
 }
+
+func TestPackageGranularity(t *testing.T) {
+	a := require.New(t)
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"go.mod":              "module example\n\ngo 1.21\n",
+		"a/util/util.go":      "package util\n\nimport \"example/b/util\"\n\nfunc A() {\n\tutil.B()\n}\n",
+		"a/util/util_test.go": "package util_test\n\nimport \"example/a/util\"\n\nvar _ = util.A\n",
+		"b/util/util.go":      "package util\n\nfunc B() {}\n",
+	} {
+		path := filepath.Join(dir, name)
+		a.NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+		a.NoError(os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	lang, err := NewLanguage(dir, &Config{})
+	a.NoError(err)
+	g := graph.NewGraph[*language.FileInfo]()
+	err = g.Load([]string{filepath.Join(dir, "a", "util", "util_test.go")}, language.NewParser(lang), nil)
+	a.NoError(err)
+	g, err = language.Granularity{Level: language.PackageLevel}.Collapse(g)
+	a.NoError(err)
+
+	var edges []string
+	for _, node := range g.AllNodes() {
+		for _, child := range g.FromId(node.Id) {
+			edges = append(edges, node.Data.RelPath+" -> "+child.Data.RelPath)
+		}
+	}
+	slices.Sort(edges)
+	a.Equal([]string{
+		"example/a/util -> example/b/util",
+		"example/a/util_test -> example/a/util",
+	}, edges)
+}
+
+func TestCountTypes(t *testing.T) {
+	a := require.New(t)
+	src := `package foo
+
+type A struct{}
+
+type (
+	B interface{ Foo() }
+	C = int
+)
+
+func D() {
+	type E struct{}
+}
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "foo.go", src, 0)
+	a.NoError(err)
+	types, abstractTypes := countTypes(file)
+	a.Equal(3, types)
+	a.Equal(1, abstractTypes)
+}
@@ -38,7 +38,7 @@ func _newFile(path string) (*File, error) {
 	return nil, fmt.Errorf("could not find file %s in any of the loaded packages", absPath)
 }
 
-var NewFile = utils.Cached1In1OutErr(_newFile)
+var NewFile, evictFile = utils.EvictableCached1In1OutErr(_newFile)
 
 type Package struct {
 	Name          string
@@ -88,4 +88,4 @@ func _packagesInDir(dir string) ([]*Package, error) {
 	return result, nil
 }
 
-var PackagesInDir = utils.Cached1In1OutErr(_packagesInDir)
+var PackagesInDir, evictPackagesInDir = utils.EvictableCached1In1OutErr(_packagesInDir)
@@ -0,0 +1,57 @@
+package graph
+
+import (
+	om "github.com/elliotchance/orderedmap/v2"
+)
+
+// Collapse returns a new graph where the nodes are merged into groups, for example, files
+// into the directories where they are located. group tells the id of the group where each
+// node belongs, and merge builds the data of a group out of the nodes that belong to it.
+// Edges between nodes in different groups become a single edge between the groups, weighted
+// by the amount of edges it stands for, and edges between nodes in the same group are
+// dropped. Groups are added in the same order as their first node, and hold all the errors
+// of their nodes. The graph is not modified.
+func (g *Graph[T]) Collapse(
+	group func(node *Node[T]) string,
+	merge func(id string, nodes []*Node[T]) T,
+) (*Graph[T], error) {
+	groups := om.NewOrderedMap[string, []*Node[T]]()
+	groupOf := make(map[string]string, g.nodes.Len())
+	for _, node := range g.AllNodes() {
+		id := group(node)
+		groupOf[node.Id] = id
+		nodes, _ := groups.Get(id)
+		groups.Set(id, append(nodes, node))
+	}
+
+	collapsed := NewGraph[T]()
+	for el := groups.Front(); el != nil; el = el.Next() {
+		node := MakeNode(el.Key, merge(el.Key, el.Value))
+		for _, member := range el.Value {
+			node.AddErrors(member.Errors...)
+		}
+		if err := collapsed.AddNode(node); err != nil {
+			return nil, err
+		}
+	}
+
+	for el := groups.Front(); el != nil; el = el.Next() {
+		for _, member := range el.Value {
+			for _, dep := range g.FromId(member.Id) {
+				to := groupOf[dep.Id]
+				if to == el.Key {
+					continue
+				}
+				data := collapsed.EdgeData(el.Key, to)
+				if data == nil {
+					data = &EdgeData{}
+				}
+				data.Weight++
+				if err := collapsed.AddEdge(el.Key, to, data); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return collapsed, nil
+}
@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraph_Collapse(t *testing.T) {
+	a := require.New(t)
+	g := MakeTestGraph([][]int{
+		0: {1, 2, 3},
+		1: {2, 4},
+		2: {4},
+		3: {4},
+		4: {0},
+	})
+	g.Get("3").AddErrors(errors.New("some error"))
+
+	// even nodes in one group, odd nodes in another one.
+	collapsed, err := g.Collapse(
+		func(node *Node[int]) string { return strconv.Itoa(node.Data % 2) },
+		func(id string, nodes []*Node[int]) int { return len(nodes) },
+	)
+	a.NoError(err)
+
+	a.Equal([]string{"0", "1"}, nodeIds(collapsed.AllNodes()))
+	a.Equal(3, collapsed.Get("0").Data)
+	a.Equal(2, collapsed.Get("1").Data)
+	a.Len(collapsed.Get("1").Errors, 1)
+
+	a.Equal([]string{"1"}, nodeIds(collapsed.FromId("0")))
+	a.Equal([]string{"0"}, nodeIds(collapsed.FromId("1")))
+	a.Equal(2, collapsed.EdgeData("0", "1").Weight)
+	a.Equal(3, collapsed.EdgeData("1", "0").Weight)
+	a.Len(collapsed.Tangles(0), 1)
+
+	// the original graph is left untouched.
+	a.Len(g.AllNodes(), 5)
+	a.Nil(g.EdgeData("0", "1"))
+}
+
+func TestGraphParser(t *testing.T) {
+	a := require.New(t)
+	g := MakeTestGraph([][]int{
+		0: {1, 2},
+		1: {2},
+		2: {},
+	})
+	a.NoError(g.AddEdge("0", "1", &EdgeData{Weight: 2}))
+
+	loaded := NewGraph[int]()
+	a.NoError(loaded.Load([]string{"1", "0"}, NewGraphParser(g), nil))
+	a.Equal([]string{"1", "2", "0"}, nodeIds(loaded.AllNodes()))
+	a.Equal(2, loaded.EdgeData("0", "1").Weight)
+
+	_, err := NewGraphParser(g).Node("3")
+	a.Error(err)
+}
+
+func nodeIds(nodes []*Node[int]) []string {
+	result := make([]string, len(nodes))
+	for i, node := range nodes {
+		result[i] = node.Id
+	}
+	return result
+}
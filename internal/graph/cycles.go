@@ -1,12 +1,6 @@
 package graph
 
 import (
-	"fmt"
-	"time"
-
-	"gonum.org/v1/gonum/graph"
-	"gonum.org/v1/gonum/graph/topo"
-
 	"github.com/gabotechs/dep-tree/internal/utils"
 )
 
@@ -54,31 +48,18 @@ func (g *Graph[T]) RemoveCyclesStartingFromNode(node *Node[T]) []Cycle {
 	return g.removeCyclesStartingFromNode(node, utils.NewCallStack(), map[string]bool{})
 }
 
-// RemoveElementaryCycles removes all the elementary cycles in the graph. The result
-// of this can be non-deterministic.
+// RemoveElementaryCycles removes edges until there are no cycles left in the graph, returning
+// one Cycle for each removed edge. Instead of enumerating every elementary cycle, which can take
+// forever in big graphs, only the tangles are traversed depth first, removing the edges that close
+// a cycle, so this always finishes in linear time and the result is deterministic.
 func (g *Graph[T]) RemoveElementaryCycles() []Cycle {
-	johnsonCycles, err := utils.ExecuteWithTimeout(
-		time.Second*3,
-		func() ([][]graph.Node, error) { return topo.DirectedCyclesIn(g), nil },
-	)
-	// TODO: there's a bug in topo.DirectedCyclesIn. In certain cases, the function can
-	//  freeze forever.
-	if err != nil {
-		fmt.Println("error executing topo.DirectedCyclesIn: ", err)
-	}
-	cycles := make([]Cycle, len(johnsonCycles))
-	for i, c := range johnsonCycles {
-		stack := make([]string, len(c))
-		for i, n := range c {
-			stack[i] = n.(*Node[T]).Id
-		}
-		g.RemoveFromToEdge(stack[0], stack[1])
-		cycles[i] = Cycle{
-			Cause: [2]string{stack[0], stack[1]},
-			Stack: stack,
+	var cycles []Cycle
+	done := map[string]bool{}
+	for _, tangle := range g.Tangles(0) {
+		for _, id := range tangle.Nodes {
+			cycles = append(cycles, g.removeCyclesStartingFromNode(g.Get(id), utils.NewCallStack(), done)...)
 		}
 	}
-
 	return cycles
 }
 
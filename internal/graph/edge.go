@@ -1,12 +1,118 @@
 package graph
 
 import (
+	"fmt"
+	"strings"
+
 	"gonum.org/v1/gonum/graph"
 )
 
 type Edge[T any] struct {
 	from *Node[T]
 	to   *Node[T]
+	data *EdgeData
+}
+
+// EdgeData tells why a node depends on another one. For source files, these are
+// the import statements in one file that import symbols from the other.
+type EdgeData struct {
+	Imports []EdgeImport
+	// Weight is the amount of edges between the original nodes that this edge stands
+	// for once the nodes are collapsed into groups, or 0 if the edge was not collapsed.
+	Weight int
+}
+
+// EdgeImport is one of the reasons why a node depends on another one.
+type EdgeImport struct {
+	// All is true if all the symbols are imported.
+	All bool
+	// Symbols are the names of the specific symbols that are imported.
+	Symbols []string
+	// TypeOnly is true if only types are imported, so the dependency does not exist at runtime.
+	TypeOnly bool
+	// Line is the line, starting from 1, where the import is located, or 0 if it's not known.
+	Line int
+	// Column is the column, starting from 1, where the import is located, or 0 if it's not known.
+	Column int
+}
+
+// Line returns the first known line among the imports, or 0 if none of them is known.
+func (d *EdgeData) Line() int {
+	if d == nil {
+		return 0
+	}
+	for _, imp := range d.Imports {
+		if imp.Line > 0 {
+			return imp.Line
+		}
+	}
+	return 0
+}
+
+// All tells if any of the imports imports all the symbols.
+func (d *EdgeData) All() bool {
+	if d == nil {
+		return false
+	}
+	for _, imp := range d.Imports {
+		if imp.All {
+			return true
+		}
+	}
+	return false
+}
+
+// Symbols returns the names of all the imported symbols, without duplicates.
+func (d *EdgeData) Symbols() []string {
+	if d == nil {
+		return nil
+	}
+	var result []string
+	seen := map[string]bool{}
+	for _, imp := range d.Imports {
+		for _, symbol := range imp.Symbols {
+			if !seen[symbol] {
+				seen[symbol] = true
+				result = append(result, symbol)
+			}
+		}
+	}
+	return result
+}
+
+// TypeOnly tells if all the imports only import types.
+func (d *EdgeData) TypeOnly() bool {
+	if d == nil || len(d.Imports) == 0 {
+		return false
+	}
+	for _, imp := range d.Imports {
+		if !imp.TypeOnly {
+			return false
+		}
+	}
+	return true
+}
+
+// Describe tells in a human-readable way what is imported, like "imports foo, bar",
+// or an empty string if nothing is known about the imports.
+func (d *EdgeData) Describe() string {
+	var description string
+	if d != nil && d.Weight == 1 {
+		description = "1 file dependency"
+	} else if d != nil && d.Weight > 1 {
+		description = fmt.Sprintf("%d file dependencies", d.Weight)
+	} else if d.All() {
+		description = "imports everything"
+	} else if symbols := d.Symbols(); len(symbols) > 0 {
+		description = "imports " + strings.Join(symbols, ", ")
+	}
+	if d.TypeOnly() {
+		if description == "" {
+			return "imports types only"
+		}
+		description += ", types only"
+	}
+	return description
 }
 
 func (e *Edge[T]) From() graph.Node {
@@ -17,9 +123,15 @@ func (e *Edge[T]) To() graph.Node {
 	return e.to
 }
 
+// Data returns why the edge exists, or nil if it's not known.
+func (e *Edge[T]) Data() *EdgeData {
+	return e.data
+}
+
 func (e *Edge[T]) ReversedEdge() graph.Edge {
 	return &Edge[T]{
 		from: e.to,
 		to:   e.from,
+		data: e.data,
 	}
 }
@@ -19,3 +19,46 @@ func TestEdge_ReversedEdge(t *testing.T) {
 	a.Equal(edge.To().ID(), hash("1"))
 	a.Equal(edge.From().ID(), hash("2"))
 }
+
+func TestEdgeData_Describe(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Data     *EdgeData
+		Line     int
+		Expected string
+	}{
+		{
+			Name: "Unknown",
+		},
+		{
+			Name:     "Symbols",
+			Data:     &EdgeData{Imports: []EdgeImport{{Symbols: []string{"foo", "bar"}}, {Symbols: []string{"foo"}, Line: 4}}},
+			Line:     4,
+			Expected: "imports foo, bar",
+		},
+		{
+			Name:     "Everything",
+			Data:     &EdgeData{Imports: []EdgeImport{{Symbols: []string{"foo"}, Line: 1}, {All: true, Line: 2}}},
+			Line:     1,
+			Expected: "imports everything",
+		},
+		{
+			Name:     "Types only",
+			Data:     &EdgeData{Imports: []EdgeImport{{Symbols: []string{"Foo"}, TypeOnly: true}}},
+			Expected: "imports Foo, types only",
+		},
+		{
+			Name:     "Some types",
+			Data:     &EdgeData{Imports: []EdgeImport{{Symbols: []string{"Foo"}, TypeOnly: true}, {}}},
+			Expected: "imports Foo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			a.Equal(tt.Line, tt.Data.Line())
+			a.Equal(tt.Expected, tt.Data.Describe())
+		})
+	}
+}
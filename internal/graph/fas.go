@@ -0,0 +1,291 @@
+package graph
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// localImprovementLimit is the biggest tangle for which the ordering found by Eades–Lin–Smyth
+// is improved afterward, as improving it takes quadratic time on the size of the tangle.
+const localImprovementLimit = 2000
+
+// FeedbackArcSet returns a set of edges that, once removed, leave the graph without cycles,
+// trying to keep the sum of their weights as small as possible. Finding the minimum one is
+// NP-hard, so it's approximated for each tangle by ordering its nodes with the Eades–Lin–Smyth
+// heuristic, moving nodes one by one to better positions in that ordering, and keeping back any
+// edge that does not close a cycle. The weight function must return positive numbers.
+func (g *Graph[T]) FeedbackArcSet(weight func(from, to *Node[T]) int) [][2]string {
+	var result [][2]string
+	for _, tangle := range g.Tangles(0) {
+		result = append(result, g.tangleFeedbackArcSet(tangle.Nodes, weight)...)
+	}
+	return result
+}
+
+// fasGraph is a dense representation of a tangle, where nodes are referenced by their index.
+type fasGraph struct {
+	// out and in hold the weight of the edges starting and ending in each node.
+	out []map[int]int
+	in  []map[int]int
+	// outNodes and inNodes hold the same nodes as out and in, but sorted, so that the
+	// graph can be traversed deterministically.
+	outNodes [][]int
+	inNodes  [][]int
+}
+
+func (g *Graph[T]) tangleFeedbackArcSet(ids []string, weight func(from, to *Node[T]) int) [][2]string {
+	indexes := make(map[string]int, len(ids))
+	for i, id := range ids {
+		indexes[id] = i
+	}
+	fg := fasGraph{
+		out:      make([]map[int]int, len(ids)),
+		in:       make([]map[int]int, len(ids)),
+		outNodes: make([][]int, len(ids)),
+		inNodes:  make([][]int, len(ids)),
+	}
+	for i := range ids {
+		fg.out[i] = map[int]int{}
+		fg.in[i] = map[int]int{}
+	}
+	for i, id := range ids {
+		from := g.Get(id)
+		for _, to := range g.FromId(id) {
+			if j, ok := indexes[to.Id]; ok {
+				w := max(weight(from, to), 1)
+				fg.out[i][j] = w
+				fg.in[j][i] = w
+				fg.outNodes[i] = append(fg.outNodes[i], j)
+				fg.inNodes[j] = append(fg.inNodes[j], i)
+			}
+		}
+	}
+	for i := range ids {
+		sort.Ints(fg.outNodes[i])
+	}
+
+	order := fg.eadesLinSmyth()
+	if len(ids) <= localImprovementLimit {
+		fg.sift(order)
+	}
+	cuts := fg.backEdges(order)
+	if len(ids) <= localImprovementLimit {
+		cuts = fg.restoreUnneeded(cuts)
+	}
+
+	result := make([][2]string, len(cuts))
+	for i, cut := range cuts {
+		result[i] = [2]string{ids[cut[0]], ids[cut[1]]}
+	}
+	return result
+}
+
+// eadesLinSmyth orders the nodes so that most of the edges, by weight, point forward. Sinks
+// are placed at the end, sources at the beginning, and if there are none, the node with the
+// biggest difference between outgoing and incoming weight is placed at the beginning.
+func (fg *fasGraph) eadesLinSmyth() []int {
+	n := len(fg.out)
+	outDeg, inDeg := make([]int, n), make([]int, n)
+	outW, inW := make([]int, n), make([]int, n)
+	for i := 0; i < n; i++ {
+		outDeg[i], inDeg[i] = len(fg.out[i]), len(fg.in[i])
+		for _, w := range fg.out[i] {
+			outW[i] += w
+		}
+		for _, w := range fg.in[i] {
+			inW[i] += w
+		}
+	}
+
+	removed := make([]bool, n)
+	var sinks, sources []int
+	candidates := &deltaHeap{}
+	for i := 0; i < n; i++ {
+		heap.Push(candidates, deltaEntry{node: i, delta: outW[i] - inW[i]})
+	}
+
+	var s1, s2 []int
+	remove := func(node int) {
+		removed[node] = true
+		for _, to := range fg.outNodes[node] {
+			if removed[to] {
+				continue
+			}
+			inDeg[to]--
+			inW[to] -= fg.out[node][to]
+			if inDeg[to] == 0 {
+				sources = append(sources, to)
+			}
+			heap.Push(candidates, deltaEntry{node: to, delta: outW[to] - inW[to]})
+		}
+		for _, from := range fg.inNodes[node] {
+			if removed[from] {
+				continue
+			}
+			outDeg[from]--
+			outW[from] -= fg.in[node][from]
+			if outDeg[from] == 0 {
+				sinks = append(sinks, from)
+			}
+			heap.Push(candidates, deltaEntry{node: from, delta: outW[from] - inW[from]})
+		}
+	}
+
+	for len(s1)+len(s2) < n {
+		switch {
+		case len(sinks) > 0:
+			node := sinks[0]
+			sinks = sinks[1:]
+			if !removed[node] {
+				s2 = append(s2, node)
+				remove(node)
+			}
+		case len(sources) > 0:
+			node := sources[0]
+			sources = sources[1:]
+			if !removed[node] {
+				s1 = append(s1, node)
+				remove(node)
+			}
+		default:
+			entry := heap.Pop(candidates).(deltaEntry)
+			// entries are never updated in place, outdated ones are just skipped.
+			if !removed[entry.node] && entry.delta == outW[entry.node]-inW[entry.node] {
+				s1 = append(s1, entry.node)
+				remove(entry.node)
+			}
+		}
+	}
+
+	for i := len(s2) - 1; i >= 0; i-- {
+		s1 = append(s1, s2[i])
+	}
+	return s1
+}
+
+// sift moves each node to the position in the order where the weight of the edges pointing
+// backward is the smallest, until no node can be moved to a better position.
+func (fg *fasGraph) sift(order []int) {
+	for improved := true; improved; {
+		improved = false
+		for _, node := range append([]int{}, order...) {
+			current := 0
+			for i, other := range order {
+				if other == node {
+					current = i
+					break
+				}
+			}
+			others := append(append([]int{}, order[:current]...), order[current+1:]...)
+
+			// cost is the weight of the backward edges that involve node when inserted at index i.
+			cost := 0
+			for _, w := range fg.in[node] {
+				cost += w
+			}
+			best, bestCost, currentCost := 0, cost, cost
+			for i, other := range others {
+				cost += fg.out[node][other] - fg.in[node][other]
+				if i+1 == current {
+					currentCost = cost
+				}
+				if cost < bestCost {
+					best, bestCost = i+1, cost
+				}
+			}
+			if bestCost >= currentCost {
+				continue
+			}
+			improved = true
+			copy(order, append(append(append([]int{}, others[:best]...), node), others[best:]...))
+		}
+	}
+}
+
+// backEdges returns the edges that point backward in the order, sorted by their origin.
+func (fg *fasGraph) backEdges(order []int) [][2]int {
+	position := make([]int, len(order))
+	for i, node := range order {
+		position[node] = i
+	}
+	var result [][2]int
+	for from := range fg.outNodes {
+		for _, to := range fg.outNodes[from] {
+			if position[to] < position[from] {
+				result = append(result, [2]int{from, to})
+			}
+		}
+	}
+	return result
+}
+
+// restoreUnneeded keeps back the edges in cuts that would not close any cycle, trying the
+// heaviest ones first.
+func (fg *fasGraph) restoreUnneeded(cuts [][2]int) [][2]int {
+	cut := make(map[[2]int]bool, len(cuts))
+	for _, edge := range cuts {
+		cut[edge] = true
+	}
+	byWeight := append([][2]int{}, cuts...)
+	sort.SliceStable(byWeight, func(i, j int) bool {
+		return fg.out[byWeight[i][0]][byWeight[i][1]] > fg.out[byWeight[j][0]][byWeight[j][1]]
+	})
+	for _, edge := range byWeight {
+		// the edge closes a cycle if its origin can be reached from its destination.
+		if !fg.reaches(edge[1], edge[0], cut) {
+			delete(cut, edge)
+		}
+	}
+	var result [][2]int
+	for _, edge := range cuts {
+		if cut[edge] {
+			result = append(result, edge)
+		}
+	}
+	return result
+}
+
+func (fg *fasGraph) reaches(from, to int, cut map[[2]int]bool) bool {
+	visited := make([]bool, len(fg.out))
+	visited[from] = true
+	stack := []int{from}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if node == to {
+			return true
+		}
+		for _, next := range fg.outNodes[node] {
+			if !visited[next] && !cut[[2]int{node, next}] {
+				visited[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+	return false
+}
+
+type deltaEntry struct {
+	node  int
+	delta int
+}
+
+// deltaHeap pops first the entry with the biggest delta, and for the same delta, the one
+// with the lowest node, so that results are deterministic.
+type deltaHeap []deltaEntry
+
+func (h deltaHeap) Len() int { return len(h) }
+func (h deltaHeap) Less(i, j int) bool {
+	if h[i].delta == h[j].delta {
+		return h[i].node < h[j].node
+	}
+	return h[i].delta > h[j].delta
+}
+func (h deltaHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *deltaHeap) Push(x any)   { *h = append(*h, x.(deltaEntry)) }
+func (h *deltaHeap) Pop() any {
+	old := *h
+	entry := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return entry
+}
@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraph_FeedbackArcSet(t *testing.T) {
+	var tests = []struct {
+		Name     string
+		Children [][]int
+		Weights  map[[2]int]int
+		Expected [][2]string
+	}{
+		{
+			Name: "No cycles",
+			Children: [][]int{
+				0: {1, 2},
+				1: {2},
+				2: {},
+			},
+		},
+		{
+			Name: "Cuts the lightest edge",
+			Children: [][]int{
+				0: {1},
+				1: {0},
+			},
+			Weights:  map[[2]int]int{{0, 1}: 1, {1, 0}: 5},
+			Expected: [][2]string{{"0", "1"}},
+		},
+		{
+			Name: "Cuts the edge shared by multiple cycles",
+			Children: [][]int{
+				0: {1},
+				1: {2, 3, 4},
+				2: {0},
+				3: {0},
+				4: {0},
+			},
+			Expected: [][2]string{{"0", "1"}},
+		},
+		{
+			Name: "Cuts several lightweight edges instead of a heavy one",
+			Children: [][]int{
+				0: {1},
+				1: {2, 3},
+				2: {0},
+				3: {0},
+			},
+			Weights:  map[[2]int]int{{0, 1}: 10},
+			Expected: [][2]string{{"2", "0"}, {"3", "0"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			g := MakeTestGraph(tt.Children)
+			cuts := g.FeedbackArcSet(func(from, to *Node[int]) int {
+				if w, ok := tt.Weights[[2]int{from.Data, to.Data}]; ok {
+					return w
+				}
+				return 1
+			})
+			a.Equal(tt.Expected, cuts)
+		})
+	}
+}
+
+func TestGraph_FeedbackArcSet_Random(t *testing.T) {
+	a := require.New(t)
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 50; i++ {
+		n := 2 + r.Intn(30)
+		spec := make([][]int, n)
+		for from := range spec {
+			for to := 0; to < n; to++ {
+				if to != from && r.Float64() < 0.15 {
+					spec[from] = append(spec[from], to)
+				}
+			}
+		}
+		// make sure that everything is reachable from node 0.
+		for to := 1; to < n; to++ {
+			spec[to-1] = append(spec[to-1], to)
+		}
+		g := MakeTestGraph(spec)
+		cuts := g.FeedbackArcSet(func(from, to *Node[int]) int { return 1 + (from.Data+to.Data)%3 })
+		a.Equal(cuts, g.FeedbackArcSet(func(from, to *Node[int]) int { return 1 + (from.Data+to.Data)%3 }))
+
+		for _, cut := range cuts {
+			g.RemoveFromToEdge(cut[0], cut[1])
+		}
+		a.Empty(g.Tangles(0), "iteration %d", i)
+
+		// none of the cuts is unnecessary.
+		for _, cut := range cuts {
+			a.NoError(g.AddFromToEdge(cut[0], cut[1]))
+			a.NotEmpty(g.Tangles(0), "%s -> %s did not need to be cut", cut[0], cut[1])
+			g.RemoveFromToEdge(cut[0], cut[1])
+		}
+	}
+}
@@ -9,12 +9,13 @@ import (
 
 type Graph[T any] struct {
 	nodes *om.OrderedMap[int64, *Node[T]]
-	// Here "from" means: from node X I can reach nodes A, B and C
+	// Here "from" means: from node X I can reach nodes A, B and C. Values tell why
+	// each edge exists, and might be nil.
 	// file -> dep
-	fromEdges *om.OrderedMap[int64, *om.OrderedMap[int64, bool]]
+	fromEdges *om.OrderedMap[int64, *om.OrderedMap[int64, *EdgeData]]
 	// Here "to" means: node X can be reached by A, B and C
 	// dep -> file
-	toEdges *om.OrderedMap[int64, *om.OrderedMap[int64, bool]]
+	toEdges *om.OrderedMap[int64, *om.OrderedMap[int64, *EdgeData]]
 }
 
 var _ graph.Directed = &Graph[any]{}
@@ -48,10 +49,10 @@ func (g *Graph[T]) HasEdgeBetween(xid, yid int64) bool {
 
 func (g *Graph[T]) Edge(uid, vid int64) graph.Edge {
 	if toNodes, ok := g.fromEdges.Get(uid); ok {
-		if _, ok := toNodes.Get(vid); ok {
+		if data, ok := toNodes.Get(vid); ok {
 			if uNode, ok := g.nodes.Get(uid); ok {
 				if vNode, ok := g.nodes.Get(vid); ok {
-					return &Edge[T]{from: uNode, to: vNode}
+					return &Edge[T]{from: uNode, to: vNode, data: data}
 				} else {
 					panic(fmt.Sprintf("there was an Edge from %d to %d, but to node did not exist", uid, vid))
 				}
@@ -80,52 +81,97 @@ func (g *Graph[T]) To(id int64) graph.Nodes {
 func NewGraph[T any]() *Graph[T] {
 	return &Graph[T]{
 		nodes:     om.NewOrderedMap[int64, *Node[T]](),
-		fromEdges: om.NewOrderedMap[int64, *om.OrderedMap[int64, bool]](),
-		toEdges:   om.NewOrderedMap[int64, *om.OrderedMap[int64, bool]](),
+		fromEdges: om.NewOrderedMap[int64, *om.OrderedMap[int64, *EdgeData]](),
+		toEdges:   om.NewOrderedMap[int64, *om.OrderedMap[int64, *EdgeData]](),
+	}
+}
+
+// lookup returns the numeric id of the provided node id, along with the node if it's
+// in the graph. A different node whose numeric id collides is never returned.
+func (g *Graph[T]) lookup(id string) (int64, *Node[T]) {
+	idHash := hashCached(id)
+	if node, ok := g.nodes.Get(idHash); ok && node.Id == id {
+		return idHash, node
 	}
+	return idHash, nil
 }
 
 func (g *Graph[T]) Has(nodeId string) bool {
-	_, ok := g.nodes.Get(hashCached(nodeId))
-	return ok
+	_, node := g.lookup(nodeId)
+	return node != nil
 }
 
-func (g *Graph[T]) AddNode(node *Node[T]) {
-	g.nodes.Set(node.ID(), node)
+// AddNode adds the node to the graph, replacing any other node with the same id. It
+// fails if the numeric id of the node collides with the one of a different node.
+func (g *Graph[T]) AddNode(node *Node[T]) error {
+	idHash := node.ID()
+	if existing, ok := g.nodes.Get(idHash); ok && existing.Id != node.Id {
+		return fmt.Errorf("cannot add '%s' to the graph, its id collides with the one of '%s'", node.Id, existing.Id)
+	}
+	g.nodes.Set(idHash, node)
+	return nil
 }
 
 func (g *Graph[T]) AddFromToEdge(fromId string, toIds ...string) error {
-	from := hashCached(fromId)
-	if _, ok := g.nodes.Get(from); !ok {
+	for _, toId := range toIds {
+		if err := g.AddEdge(fromId, toId, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddEdge adds an edge between two nodes that are already in the graph, along with the
+// data that tells why the edge exists. If the edge already existed, nil data does not
+// replace the one it had.
+func (g *Graph[T]) AddEdge(fromId string, toId string, data *EdgeData) error {
+	from, fromNode := g.lookup(fromId)
+	if fromNode == nil {
 		return fmt.Errorf("'%s' is not in graph", fromId)
 	}
+	to, toNode := g.lookup(toId)
+	if toNode == nil {
+		return fmt.Errorf("'%s' is not in graph", toId)
+	}
+	toNodes, ok := g.fromEdges.Get(from)
+	if !ok {
+		toNodes = om.NewOrderedMap[int64, *EdgeData]()
+		g.fromEdges.Set(from, toNodes)
+	}
+	fromNodes, ok := g.toEdges.Get(to)
+	if !ok {
+		fromNodes = om.NewOrderedMap[int64, *EdgeData]()
+		g.toEdges.Set(to, fromNodes)
+	}
+	if existing, ok := toNodes.Get(to); ok && data == nil {
+		data = existing
+	}
+	toNodes.Set(to, data)
+	fromNodes.Set(from, data)
+	return nil
+}
 
-	for _, toId := range toIds {
-		to := hashCached(toId)
-		if _, ok := g.nodes.Get(to); !ok {
-			return fmt.Errorf("'%s' is not in graph", toId)
-		}
-		if toNodes, ok := g.fromEdges.Get(from); ok {
-			toNodes.Set(to, true)
-		} else {
-			toNodes = om.NewOrderedMap[int64, bool]()
-			toNodes.Set(to, true)
-			g.fromEdges.Set(from, toNodes)
-		}
-		if fromNodes, ok := g.toEdges.Get(to); ok {
-			fromNodes.Set(from, true)
-		} else {
-			fromNodes = om.NewOrderedMap[int64, bool]()
-			fromNodes.Set(from, true)
-			g.toEdges.Set(to, fromNodes)
-		}
+// EdgeData returns why fromId depends on toId, or nil if it's not known or there
+// is no such edge.
+func (g *Graph[T]) EdgeData(fromId string, toId string) *EdgeData {
+	from, fromNode := g.lookup(fromId)
+	to, toNode := g.lookup(toId)
+	if fromNode == nil || toNode == nil {
+		return nil
+	}
+	if toNodes, ok := g.fromEdges.Get(from); ok {
+		data, _ := toNodes.Get(to)
+		return data
 	}
 	return nil
 }
 
 func (g *Graph[T]) RemoveFromToEdge(fromId string, toId string) {
-	from := hashCached(fromId)
-	to := hashCached(toId)
+	from, fromNode := g.lookup(fromId)
+	to, toNode := g.lookup(toId)
+	if fromNode == nil || toNode == nil {
+		return
+	}
 	if toNodes, ok := g.fromEdges.Get(from); ok {
 		toNodes.Delete(to)
 	}
@@ -144,13 +190,17 @@ func (g *Graph[T]) AllNodes() []*Node[T] {
 }
 
 func (g *Graph[T]) Get(id string) *Node[T] {
-	node, _ := g.nodes.Get(hashCached(id))
+	_, node := g.lookup(id)
 	return node
 }
 
 // FromId returns the nodes to which id can reach.
 func (g *Graph[T]) FromId(id string) []*Node[T] {
-	return g.from(hashCached(id))
+	idHash, node := g.lookup(id)
+	if node == nil {
+		return make([]*Node[T], 0)
+	}
+	return g.from(idHash)
 }
 
 func (g *Graph[T]) from(idHash int64) []*Node[T] {
@@ -169,7 +219,11 @@ func (g *Graph[T]) from(idHash int64) []*Node[T] {
 
 // ToId returns the nodes from which id is reachable.
 func (g *Graph[T]) ToId(id string) []*Node[T] {
-	return g.to(hashCached(id))
+	idHash, node := g.lookup(id)
+	if node == nil {
+		return make([]*Node[T], 0)
+	}
+	return g.to(idHash)
 }
 
 func (g *Graph[T]) to(idHash int64) []*Node[T] {
@@ -199,3 +253,50 @@ func (g *Graph[T]) GetNodesWithoutParents() []*Node[T] {
 	}
 	return result
 }
+
+// RemoveNode removes the node along with all the edges that start or end in it.
+func (g *Graph[T]) RemoveNode(id string) {
+	idHash, node := g.lookup(id)
+	if node == nil {
+		return
+	}
+	if toNodes, ok := g.fromEdges.Get(idHash); ok {
+		for _, to := range toNodes.Keys() {
+			if fromNodes, ok := g.toEdges.Get(to); ok {
+				fromNodes.Delete(idHash)
+			}
+		}
+		g.fromEdges.Delete(idHash)
+	}
+	if fromNodes, ok := g.toEdges.Get(idHash); ok {
+		for _, from := range fromNodes.Keys() {
+			if toNodes, ok := g.fromEdges.Get(from); ok {
+				toNodes.Delete(idHash)
+			}
+		}
+		g.toEdges.Delete(idHash)
+	}
+	g.nodes.Delete(idHash)
+}
+
+// Clone returns a copy of the graph that shares the nodes, but not the edges, so
+// that edges can be removed from it without affecting the original graph.
+func (g *Graph[T]) Clone() *Graph[T] {
+	clone := NewGraph[T]()
+	for el := g.nodes.Front(); el != nil; el = el.Next() {
+		clone.nodes.Set(el.Key, el.Value)
+	}
+	for _, edges := range []struct {
+		src *om.OrderedMap[int64, *om.OrderedMap[int64, *EdgeData]]
+		dst *om.OrderedMap[int64, *om.OrderedMap[int64, *EdgeData]]
+	}{{g.fromEdges, clone.fromEdges}, {g.toEdges, clone.toEdges}} {
+		for el := edges.src.Front(); el != nil; el = el.Next() {
+			nodes := om.NewOrderedMap[int64, *EdgeData]()
+			for edge := el.Value.Front(); edge != nil; edge = edge.Next() {
+				nodes.Set(edge.Key, edge.Value)
+			}
+			edges.dst.Set(el.Key, nodes)
+		}
+	}
+	return clone
+}
@@ -0,0 +1,37 @@
+package graph
+
+import "fmt"
+
+// GraphParser is a NodeParser that reads nodes and their dependencies from an already
+// loaded graph, so that graphs built in other ways, like collapsed ones, can be used by
+// anything that loads graphs through a NodeParser.
+type GraphParser[T any] struct {
+	Graph *Graph[T]
+}
+
+var _ EdgesNodeParser[any] = &GraphParser[any]{}
+
+func NewGraphParser[T any](g *Graph[T]) *GraphParser[T] {
+	return &GraphParser[T]{Graph: g}
+}
+
+func (p *GraphParser[T]) Node(id string) (*Node[T], error) {
+	node := p.Graph.Get(id)
+	if node == nil {
+		return nil, fmt.Errorf("'%s' is not in graph", id)
+	}
+	return node, nil
+}
+
+func (p *GraphParser[T]) Deps(node *Node[T]) ([]*Node[T], error) {
+	return p.Graph.FromId(node.Id), nil
+}
+
+func (p *GraphParser[T]) DepsWithEdges(node *Node[T]) ([]*Node[T], []*EdgeData, error) {
+	deps := p.Graph.FromId(node.Id)
+	edges := make([]*EdgeData, len(deps))
+	for i, dep := range deps {
+		edges[i] = p.Graph.EdgeData(node.Id, dep.Id)
+	}
+	return deps, edges, nil
+}
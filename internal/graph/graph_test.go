@@ -27,6 +27,23 @@ func TestGraph_AddNode(t *testing.T) {
 	a.Equal(false, g.Has("2"))
 }
 
+func TestGraph_AddNode_Collision(t *testing.T) {
+	a := require.New(t)
+	original := hashCached
+	hashCached = func(string) int64 { return 1 }
+	defer func() { hashCached = original }()
+
+	g := NewGraph[int]()
+	a.NoError(g.AddNode(MakeNode("a", 1)))
+	a.NoError(g.AddNode(MakeNode("a", 2)))
+	a.ErrorContains(g.AddNode(MakeNode("b", 3)), "'b'")
+	a.True(g.Has("a"))
+	a.False(g.Has("b"))
+	a.Nil(g.Get("b"))
+	a.Equal(2, g.Get("a").Data)
+	a.Error(g.AddFromToEdge("a", "b"))
+}
+
 func TestGraph_AddFromToEdge(t *testing.T) {
 	a := require.New(t)
 	g := NewGraph[int]()
@@ -50,6 +67,28 @@ func TestGraph_AddFromToEdge(t *testing.T) {
 	a.Equal(0, len(g.FromId("1")))
 }
 
+func TestGraph_AddEdge(t *testing.T) {
+	a := require.New(t)
+	g := NewGraph[int]()
+	a.NoError(g.AddNode(MakeNode[int]("0", 0)))
+	a.NoError(g.AddNode(MakeNode[int]("1", 1)))
+	data := &EdgeData{Imports: []EdgeImport{{Symbols: []string{"foo"}, Line: 3}}}
+	a.NoError(g.AddEdge("0", "1", data))
+	a.Error(g.AddEdge("0", "2", data))
+
+	a.Equal(data, g.EdgeData("0", "1"))
+	a.Nil(g.EdgeData("1", "0"))
+	a.Nil(g.EdgeData("0", "2"))
+
+	// adding the edge again without data keeps the existing one.
+	a.NoError(g.AddFromToEdge("0", "1"))
+	a.Equal(data, g.EdgeData("0", "1"))
+	a.Equal(data, g.Clone().EdgeData("0", "1"))
+
+	g.RemoveFromToEdge("0", "1")
+	a.Nil(g.EdgeData("0", "1"))
+}
+
 func TestGraph_Cycles(t *testing.T) {
 	a := require.New(t)
 	g := NewGraph[int]()
@@ -98,3 +137,39 @@ func TestGraph_GetNodesWithoutParents(t *testing.T) {
 	nodes = g.GetNodesWithoutParents()
 	a.Equal(0, len(nodes))
 }
+
+func TestGraph_RemoveNode(t *testing.T) {
+	a := require.New(t)
+	g := MakeTestGraph([][]int{
+		0: {1, 2},
+		1: {2},
+		2: {0},
+	})
+
+	g.RemoveNode("2")
+	a.False(g.Has("2"))
+	a.Equal([]*Node[int]{g.Get("1")}, g.FromId("0"))
+	a.Empty(g.FromId("1"))
+	a.Empty(g.ToId("0"))
+}
+
+func TestGraph_Clone(t *testing.T) {
+	a := require.New(t)
+	g := MakeTestGraph([][]int{
+		0: {1, 2},
+		1: {2},
+		2: {0},
+	})
+
+	clone := g.Clone()
+	a.Equal(g.AllNodes(), clone.AllNodes())
+	clone.RemoveFromToEdge("2", "0")
+	clone.RemoveNode("1")
+
+	a.Len(g.AllNodes(), 3)
+	a.Len(g.FromId("0"), 2)
+	a.Len(g.FromId("2"), 1)
+	a.Len(clone.AllNodes(), 2)
+	a.Len(clone.FromId("0"), 1)
+	a.Empty(clone.FromId("2"))
+}
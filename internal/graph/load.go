@@ -3,8 +3,8 @@ package graph
 import (
 	"fmt"
 	"os"
+	"sync"
 
-	"github.com/gammazero/deque"
 	"github.com/schollz/progressbar/v3"
 )
 
@@ -15,10 +15,80 @@ type NodeParser[T any] interface {
 
 type NodeParserBuilder[T any] func([]string) (NodeParser[T], error)
 
+// ConcurrentNodeParser is implemented by NodeParsers that are safe to use from
+// multiple goroutines. Concurrency tells how many nodes can be parsed at the same time.
+type ConcurrentNodeParser interface {
+	Concurrency() int
+}
+
+// EdgesNodeParser is implemented by NodeParsers that can tell why a node depends on each one
+// of its dependencies. DepsWithEdges returns the same as Deps, along with the EdgeData for each
+// one of the dependencies, in the same order.
+type EdgesNodeParser[T any] interface {
+	DepsWithEdges(node *Node[T]) ([]*Node[T], []*EdgeData, error)
+}
+
+type depsResult[T any] struct {
+	deps  []*Node[T]
+	edges []*EdgeData
+	err   error
+}
+
+func (r *depsResult[T]) edge(i int) *EdgeData {
+	if i < len(r.edges) {
+		return r.edges[i]
+	}
+	return nil
+}
+
+func parseNodeDeps[T any](node *Node[T], parser NodeParser[T]) depsResult[T] {
+	var result depsResult[T]
+	if edgesParser, ok := parser.(EdgesNodeParser[T]); ok {
+		result.deps, result.edges, result.err = edgesParser.DepsWithEdges(node)
+	} else {
+		result.deps, result.err = parser.Deps(node)
+	}
+	return result
+}
+
+// parseDeps gathers the dependencies of all the provided nodes, using up to
+// jobs goroutines. Results are returned in the same order as the nodes.
+func parseDeps[T any](nodes []*Node[T], parser NodeParser[T], jobs int) []depsResult[T] {
+	results := make([]depsResult[T], len(nodes))
+	if jobs <= 1 || len(nodes) == 1 {
+		for i, node := range nodes {
+			results[i] = parseNodeDeps(node, parser)
+		}
+		return results
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(jobs, len(nodes)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = parseNodeDeps(nodes[i], parser)
+			}
+		}()
+	}
+	for i := range nodes {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
+// Load walks the graph breadth first starting from the provided ids. If the parser is
+// a ConcurrentNodeParser, the nodes of each level are parsed concurrently, but they
+// are always added to the graph in the same order as if they were parsed one by one.
 func (g *Graph[T]) Load(ids []string, parser NodeParser[T], callbacks LoadCallbacks[T]) error {
 	if callbacks == nil {
 		callbacks = &EmptyCallbacks[T]{}
 	}
+	jobs := concurrency(parser)
 	visited := make(map[string]bool)
 	callbacks.onStartLoading(ids)
 
@@ -30,46 +100,76 @@ func (g *Graph[T]) Load(ids []string, parser NodeParser[T], callbacks LoadCallba
 		if node == nil {
 			continue
 		}
-		var queue deque.Deque[*Node[T]]
-		queue.PushBack(node)
 		if !g.Has(node.Id) {
-			g.AddNode(node)
+			if err = g.AddNode(node); err != nil {
+				return err
+			}
 		}
-		for queue.Len() > 0 {
-			node := queue.PopFront()
+		err = g.walk([]*Node[T]{node}, visited, parser, jobs, callbacks)
+		if err != nil {
+			return err
+		}
+	}
+	callbacks.onFinishLoad()
+
+	return nil
+}
+
+// walk loads breadth first the dependencies of the frontier nodes that were not visited yet.
+func (g *Graph[T]) walk(
+	frontier []*Node[T],
+	visited map[string]bool,
+	parser NodeParser[T],
+	jobs int,
+	callbacks LoadCallbacks[T],
+) error {
+	for len(frontier) > 0 {
+		var level []*Node[T]
+		for _, node := range frontier {
 			if _, ok := visited[node.Id]; ok {
 				continue
 			}
 			visited[node.Id] = true
+			level = append(level, node)
+		}
+		frontier = nil
 
-			deps, err := parser.Deps(node)
-			if err != nil {
-				node.AddErrors(err)
+		for i, result := range parseDeps(level, parser, jobs) {
+			node := level[i]
+			if result.err != nil {
+				node.AddErrors(result.err)
 				continue
 			}
-			callbacks.onNodeLoaded(node, deps)
+			callbacks.onNodeLoaded(node, result.deps)
 
-			for _, dep := range deps {
+			for j, dep := range result.deps {
 				// No own child.
 				if dep.Id == node.Id {
 					continue
 				}
 				if !g.Has(dep.Id) {
-					g.AddNode(dep)
+					if err := g.AddNode(dep); err != nil {
+						return err
+					}
 				}
-				err = g.AddFromToEdge(node.Id, dep.Id)
-				queue.PushBack(dep)
+				err := g.AddEdge(node.Id, dep.Id, result.edge(j))
+				frontier = append(frontier, dep)
 				if err != nil {
 					return err
 				}
 			}
 		}
 	}
-	callbacks.onFinishLoad()
-
 	return nil
 }
 
+func concurrency[T any](parser NodeParser[T]) int {
+	if concurrent, ok := parser.(ConcurrentNodeParser); ok {
+		return concurrent.Concurrency()
+	}
+	return 1
+}
+
 type LoadCallbacks[T any] interface {
 	onStartLoading(initialIds []string)
 	onNodeLoaded(node *Node[T], deps []*Node[T])
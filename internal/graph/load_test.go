@@ -199,3 +199,58 @@ func TestLoadDeps_loadGraph(t *testing.T) {
 		})
 	}
 }
+
+type concurrentTestParser struct {
+	TestParser
+	jobs int
+}
+
+func (c *concurrentTestParser) Concurrency() int {
+	return c.jobs
+}
+
+func TestLoadDeps_Concurrent(t *testing.T) {
+	a := require.New(t)
+	spec := [][]int{
+		0:  {1, 2, 3},
+		1:  {4, 5},
+		2:  {5, 6, 1},
+		3:  {7, 8, 9},
+		4:  {10},
+		5:  {10, 11},
+		6:  {2},
+		7:  {},
+		8:  {11, 0},
+		9:  {-1},
+		10: {},
+		11: {7},
+	}
+
+	load := func(jobs int) *Graph[[]int] {
+		g := NewGraph[[]int]()
+		err := g.Load([]string{"0", "6"}, &concurrentTestParser{TestParser{Spec: spec}, jobs}, nil)
+		a.NoError(err)
+		return g
+	}
+	summary := func(g *Graph[[]int]) []string {
+		var result []string
+		for _, node := range g.AllNodes() {
+			entry := node.Id + ":"
+			for _, dep := range g.FromId(node.Id) {
+				entry += " " + dep.Id
+			}
+			for _, err := range node.Errors {
+				entry += " " + err.Error()
+			}
+			result = append(result, entry)
+		}
+		return result
+	}
+
+	expected := summary(load(1))
+	for _, jobs := range []int{2, 4, 16} {
+		for i := 0; i < 10; i++ {
+			a.Equal(expected, summary(load(jobs)))
+		}
+	}
+}
@@ -20,10 +20,17 @@ type Node[T any] struct {
 	Data T
 }
 
+// idMask keeps node ids within the 53 bits that a JavaScript number can represent
+// exactly, as ids end up in the JSON consumed by the entropy visualization.
+const idMask = 1<<53 - 1
+
+// hash computes the numeric id of a node. Ids are derived from the node's string id,
+// so they are stable across runs. Two different strings are unlikely to collide in
+// 53 bits, but if they do, the Graph detects it and refuses to add the second node.
 func hash(s string) int64 {
-	h := fnv.New32a()
+	h := fnv.New64a()
 	_, _ = h.Write([]byte(s))
-	return int64(h.Sum32())
+	return int64(h.Sum64() & idMask)
 }
 
 var hashCached = utils.Cached1In1Out(hash)
@@ -0,0 +1,116 @@
+package graph
+
+// Refresh updates the graph after some of its nodes changed, without loading it again
+// from scratch. The changed nodes, and the ones that depend on them, are parsed again
+// and their edges are replaced. New dependencies are loaded, and the nodes that are
+// no longer reachable from the entrypoints are removed.
+//
+// The parser must not return stale results for the changed nodes, so any cache
+// that it holds for them must be invalidated before calling Refresh.
+func (g *Graph[T]) Refresh(entrypoints []string, changed []string, parser NodeParser[T], callbacks LoadCallbacks[T]) error {
+	if callbacks == nil {
+		callbacks = &EmptyCallbacks[T]{}
+	}
+	callbacks.onStartLoading(changed)
+
+	var refresh []*Node[T]
+	seen := make(map[string]bool)
+	add := func(node *Node[T]) {
+		if !seen[node.Id] {
+			seen[node.Id] = true
+			refresh = append(refresh, node)
+		}
+	}
+	for _, id := range changed {
+		existing := g.Get(id)
+		if existing == nil {
+			continue
+		}
+		// parents are parsed again too, as they might be resolving their
+		// imports through the changed node, or importing it if it was removed.
+		parents := g.ToId(id)
+		node, err := parser.Node(id)
+		if err != nil || node == nil {
+			g.RemoveNode(id)
+		} else {
+			existing.Data = node.Data
+			add(existing)
+		}
+		for _, parent := range parents {
+			add(parent)
+		}
+	}
+
+	var level []*Node[T]
+	for _, node := range refresh {
+		if g.Has(node.Id) {
+			node.Errors = make([]error, 0)
+			level = append(level, node)
+		}
+	}
+	visited := make(map[string]bool)
+	for _, node := range g.AllNodes() {
+		visited[node.Id] = true
+	}
+
+	var frontier []*Node[T]
+	for i, result := range parseDeps(level, parser, concurrency(parser)) {
+		node := level[i]
+		for _, dep := range g.FromId(node.Id) {
+			g.RemoveFromToEdge(node.Id, dep.Id)
+		}
+		if result.err != nil {
+			node.AddErrors(result.err)
+			continue
+		}
+		callbacks.onNodeLoaded(node, result.deps)
+
+		for j, dep := range result.deps {
+			// No own child.
+			if dep.Id == node.Id {
+				continue
+			}
+			if !g.Has(dep.Id) {
+				if err := g.AddNode(dep); err != nil {
+					return err
+				}
+				frontier = append(frontier, dep)
+			}
+			if err := g.AddEdge(node.Id, dep.Id, result.edge(j)); err != nil {
+				return err
+			}
+		}
+	}
+	err := g.walk(frontier, visited, parser, concurrency(parser), callbacks)
+	if err != nil {
+		return err
+	}
+	g.removeUnreachable(entrypoints)
+	callbacks.onFinishLoad()
+	return nil
+}
+
+// removeUnreachable removes the nodes that cannot be reached from any of the entrypoints.
+func (g *Graph[T]) removeUnreachable(entrypoints []string) {
+	reachable := make(map[string]bool)
+	var stack []*Node[T]
+	for _, id := range entrypoints {
+		if node := g.Get(id); node != nil {
+			stack = append(stack, node)
+		}
+	}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if reachable[node.Id] {
+			continue
+		}
+		reachable[node.Id] = true
+		stack = append(stack, g.FromId(node.Id)...)
+	}
+	for _, node := range g.AllNodes() {
+		if !reachable[node.Id] {
+			g.RemoveNode(node.Id)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func edgesById(g *Graph[[]int]) map[string][]string {
+	result := make(map[string][]string)
+	for _, node := range g.AllNodes() {
+		result[node.Id] = make([]string, 0)
+		for _, dep := range g.FromId(node.Id) {
+			result[node.Id] = append(result[node.Id], dep.Id)
+		}
+	}
+	return result
+}
+
+func TestGraph_Refresh(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Spec    [][]int
+		NewSpec [][]int
+		Changed []string
+	}{
+		{
+			Name:    "new dependency",
+			Spec:    [][]int{0: {1}, 1: {}, 2: {}},
+			NewSpec: [][]int{0: {1, 2}, 1: {}, 2: {}},
+			Changed: []string{"0"},
+		},
+		{
+			Name:    "new transitive dependencies",
+			Spec:    [][]int{0: {1}, 1: {}, 2: {3}, 3: {0}},
+			NewSpec: [][]int{0: {1}, 1: {2}, 2: {3}, 3: {0}},
+			Changed: []string{"1"},
+		},
+		{
+			Name:    "removed dependency prunes unreachable nodes",
+			Spec:    [][]int{0: {1, 2}, 1: {}, 2: {3}, 3: {}},
+			NewSpec: [][]int{0: {1}, 1: {}, 2: {3}, 3: {}},
+			Changed: []string{"0"},
+		},
+		{
+			Name:    "removed dependency that is still reachable",
+			Spec:    [][]int{0: {1, 2}, 1: {2}, 2: {}},
+			NewSpec: [][]int{0: {1}, 1: {2}, 2: {}},
+			Changed: []string{"0"},
+		},
+		{
+			Name:    "removed node",
+			Spec:    [][]int{0: {1, 2}, 1: {}, 2: {}},
+			NewSpec: [][]int{0: {1, 2}, 1: {}},
+			Changed: []string{"2"},
+		},
+		{
+			Name:    "several changes",
+			Spec:    [][]int{0: {1, 2}, 1: {3}, 2: {}, 3: {}, 4: {}},
+			NewSpec: [][]int{0: {1, 2}, 1: {}, 2: {4}, 3: {}, 4: {2}},
+			Changed: []string{"1", "2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			parser := &TestParser{Spec: tt.Spec}
+			g := NewGraph[[]int]()
+			a.NoError(g.Load([]string{"0"}, parser, nil))
+
+			parser.Spec = tt.NewSpec
+			a.NoError(g.Refresh([]string{"0"}, tt.Changed, parser, nil))
+
+			expected := NewGraph[[]int]()
+			a.NoError(expected.Load([]string{"0"}, &TestParser{Spec: tt.NewSpec}, nil))
+			a.Equal(edgesById(expected), edgesById(g))
+		})
+	}
+}
+
+func TestGraph_Refresh_Errors(t *testing.T) {
+	a := require.New(t)
+	parser := &TestParser{Spec: [][]int{0: {1}, 1: {}}}
+	g := NewGraph[[]int]()
+	a.NoError(g.Load([]string{"0"}, parser, nil))
+
+	parser.Spec = [][]int{0: {1}, 1: {-1}}
+	a.NoError(g.Refresh([]string{"0"}, []string{"1"}, parser, nil))
+	a.Len(g.Get("1").Errors, 1)
+
+	parser.Spec = [][]int{0: {1}, 1: {}}
+	a.NoError(g.Refresh([]string{"0"}, []string{"1"}, parser, nil))
+	a.Len(g.Get("1").Errors, 0)
+}
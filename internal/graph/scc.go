@@ -0,0 +1,244 @@
+package graph
+
+import (
+	"sort"
+	"strings"
+)
+
+// Tangle is a group of nodes that all depend on each other, either directly or
+// transitively. In graph terms, it's a strongly connected component with more than one node.
+type Tangle struct {
+	// Nodes are the ids of the nodes in the tangle, in the same order as they were added to the graph.
+	Nodes []string
+	// Cycles are some elementary cycles that show how the nodes in the tangle depend on each other.
+	Cycles []Cycle
+}
+
+// StronglyConnectedComponents computes the strongly connected components of the graph using
+// Tarjan's algorithm. Both the components and the nodes in them are returned in the same order
+// as they were added to the graph.
+func (g *Graph[T]) StronglyConnectedComponents() [][]string {
+	nodes := g.AllNodes()
+	indexes := make(map[string]int, len(nodes))
+	for i, node := range nodes {
+		indexes[node.Id] = i
+	}
+	children := make([][]int, len(nodes))
+	for i, node := range nodes {
+		for _, child := range g.FromId(node.Id) {
+			children[i] = append(children[i], indexes[child.Id])
+		}
+	}
+
+	// The algorithm is implemented iteratively, so that it does not blow up the
+	// stack with very deep graphs.
+	type frame struct {
+		node  int
+		child int
+	}
+	index := make([]int, len(nodes))
+	lowLink := make([]int, len(nodes))
+	onStack := make([]bool, len(nodes))
+	var stack []int
+	var components [][]int
+	next := 1
+
+	for root := range nodes {
+		if index[root] != 0 {
+			continue
+		}
+		callStack := []frame{{node: root}}
+		index[root], lowLink[root] = next, next
+		next++
+		stack = append(stack, root)
+		onStack[root] = true
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			if top.child < len(children[top.node]) {
+				child := children[top.node][top.child]
+				top.child++
+				if index[child] == 0 {
+					index[child], lowLink[child] = next, next
+					next++
+					stack = append(stack, child)
+					onStack[child] = true
+					callStack = append(callStack, frame{node: child})
+				} else if onStack[child] {
+					lowLink[top.node] = min(lowLink[top.node], index[child])
+				}
+				continue
+			}
+
+			node := top.node
+			callStack = callStack[:len(callStack)-1]
+			if len(callStack) > 0 {
+				parent := callStack[len(callStack)-1].node
+				lowLink[parent] = min(lowLink[parent], lowLink[node])
+			}
+			if lowLink[node] != index[node] {
+				continue
+			}
+			var component []int
+			for {
+				last := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[last] = false
+				component = append(component, last)
+				if last == node {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	// Tarjan's algorithm emits the components in reverse topological order, sort them
+	// so that the result follows the order of the graph instead.
+	first := make([]int, len(components))
+	for i, component := range components {
+		first[i] = len(nodes)
+		for _, node := range component {
+			first[i] = min(first[i], node)
+		}
+	}
+	byFirst := make([][]int, len(nodes))
+	for i, component := range components {
+		byFirst[first[i]] = component
+	}
+	result := make([][]string, 0, len(components))
+	for _, component := range byFirst {
+		if component == nil {
+			continue
+		}
+		sort.Ints(component)
+		ids := make([]string, len(component))
+		for i, node := range component {
+			ids[i] = nodes[node].Id
+		}
+		result = append(result, ids)
+	}
+	return result
+}
+
+// Tangles returns the strongly connected components of the graph that have more than
+// one node. For each one of them, up to maxCycles elementary cycles are gathered, which
+// are the shortest ones passing through the nodes of the tangle.
+func (g *Graph[T]) Tangles(maxCycles int) []Tangle {
+	var tangles []Tangle
+	for _, component := range g.StronglyConnectedComponents() {
+		if len(component) < 2 {
+			continue
+		}
+		tangles = append(tangles, Tangle{
+			Nodes:  component,
+			Cycles: g.shortestCycles(component, maxCycles),
+		})
+	}
+	return tangles
+}
+
+// shortestCycles looks for the shortest cycle that passes through each one of the nodes
+// in the strongly connected component, until maxCycles different cycles are found.
+func (g *Graph[T]) shortestCycles(component []string, maxCycles int) []Cycle {
+	position := make(map[string]int, len(component))
+	for i, id := range component {
+		position[id] = i
+	}
+	var cycles []Cycle
+	seen := make(map[string]bool)
+	for _, start := range component {
+		if len(cycles) >= maxCycles {
+			break
+		}
+		stack := g.shortestCycle(start, position)
+		if stack == nil {
+			continue
+		}
+		// Rotate the cycle so that it starts in the node that appears first in the
+		// component, that way the same cycle found from different nodes is deduplicated.
+		lowest := 0
+		for i, id := range stack[:len(stack)-1] {
+			if position[id] < position[stack[lowest]] {
+				lowest = i
+			}
+		}
+		rotated := append(append([]string{}, stack[lowest:len(stack)-1]...), stack[:lowest+1]...)
+		key := strings.Join(rotated, "\x00")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		cycles = append(cycles, Cycle{
+			Cause: [2]string{rotated[len(rotated)-2], rotated[len(rotated)-1]},
+			Stack: rotated,
+		})
+	}
+	return cycles
+}
+
+// shortestCycle performs a breadth first search from start, only through nodes in the
+// component, until start is reached again. The returned stack starts and ends in start.
+func (g *Graph[T]) shortestCycle(start string, component map[string]int) []string {
+	parents := map[string]string{}
+	queue := []string{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, child := range g.FromId(current) {
+			if _, ok := component[child.Id]; !ok {
+				continue
+			}
+			if child.Id == start {
+				stack := []string{start}
+				for id := current; id != start; id = parents[id] {
+					stack = append(stack, id)
+				}
+				stack = append(stack, start)
+				for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
+					stack[i], stack[j] = stack[j], stack[i]
+				}
+				return stack
+			}
+			if _, ok := parents[child.Id]; ok {
+				continue
+			}
+			parents[child.Id] = current
+			queue = append(queue, child.Id)
+		}
+	}
+	return nil
+}
+
+// ShortestCycleThrough returns the shortest cycle that goes through the edge from -> to,
+// starting and ending in from, or nil if there is no such edge or to does not depend on from.
+func (g *Graph[T]) ShortestCycleThrough(from, to string) []string {
+	fromNode, toNode := g.Get(from), g.Get(to)
+	if fromNode == nil || toNode == nil || !g.HasEdgeFromTo(fromNode.ID(), toNode.ID()) {
+		return nil
+	}
+	parents := map[string]string{to: ""}
+	queue := []string{to}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == from {
+			stack := []string{from}
+			for id := current; id != to; id = parents[id] {
+				stack = append(stack, parents[id])
+			}
+			stack = append(stack, from)
+			for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
+				stack[i], stack[j] = stack[j], stack[i]
+			}
+			return stack
+		}
+		for _, child := range g.FromId(current) {
+			if _, ok := parents[child.Id]; !ok {
+				parents[child.Id] = current
+				queue = append(queue, child.Id)
+			}
+		}
+	}
+	return nil
+}
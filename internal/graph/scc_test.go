@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraph_StronglyConnectedComponents(t *testing.T) {
+	var tests = []struct {
+		Name     string
+		Children [][]int
+		Expected [][]string
+	}{
+		{
+			Name: "No cycles",
+			Children: [][]int{
+				0: {1, 2},
+				1: {3},
+				2: {3},
+				3: {},
+			},
+			Expected: [][]string{{"0"}, {"1"}, {"2"}, {"3"}},
+		},
+		{
+			Name: "Two tangles",
+			Children: [][]int{
+				0: {1, 3},
+				1: {2},
+				2: {1},
+				3: {4},
+				4: {5},
+				5: {3, 1},
+			},
+			Expected: [][]string{{"0"}, {"1", "2"}, {"3", "4", "5"}},
+		},
+		{
+			Name: "Everything tangled",
+			Children: [][]int{
+				0: {1},
+				1: {0, 2},
+				2: {3},
+				3: {4},
+				4: {0},
+			},
+			Expected: [][]string{{"0", "1", "2", "3", "4"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			g := MakeTestGraph(tt.Children)
+			a.Equal(tt.Expected, g.StronglyConnectedComponents())
+		})
+	}
+}
+
+func TestGraph_Tangles(t *testing.T) {
+	a := require.New(t)
+	g := MakeTestGraph([][]int{
+		0: {1, 3},
+		1: {2},
+		2: {1},
+		3: {4, 5},
+		4: {3},
+		5: {3, 4},
+	})
+
+	tangles := g.Tangles(10)
+	a.Len(tangles, 2)
+	a.Equal([]string{"1", "2"}, tangles[0].Nodes)
+	a.Equal([]Cycle{{Cause: [2]string{"2", "1"}, Stack: []string{"1", "2", "1"}}}, tangles[0].Cycles)
+	a.Equal([]string{"3", "4", "5"}, tangles[1].Nodes)
+	a.Equal([]Cycle{
+		{Cause: [2]string{"4", "3"}, Stack: []string{"3", "4", "3"}},
+		{Cause: [2]string{"5", "3"}, Stack: []string{"3", "5", "3"}},
+	}, tangles[1].Cycles)
+
+	tangles = g.Tangles(1)
+	a.Len(tangles[1].Cycles, 1)
+	a.Empty(g.Tangles(0)[1].Cycles)
+}
+
+func TestGraph_ShortestCycleThrough(t *testing.T) {
+	a := require.New(t)
+	g := MakeTestGraph([][]int{
+		0: {1},
+		1: {2, 3},
+		2: {0},
+		3: {4},
+		4: {1},
+	})
+
+	a.Equal([]string{"0", "1", "2", "0"}, g.ShortestCycleThrough("0", "1"))
+	a.Equal([]string{"1", "3", "4", "1"}, g.ShortestCycleThrough("1", "3"))
+	a.Equal([]string{"4", "1", "3", "4"}, g.ShortestCycleThrough("4", "1"))
+	a.Nil(g.ShortestCycleThrough("1", "0"))
+	a.Nil(g.ShortestCycleThrough("1", "5"))
+}
+
+func TestGraph_RemoveElementaryCycles(t *testing.T) {
+	a := require.New(t)
+	// A graph full of elementary cycles, enumerating all of them would take forever.
+	const n = 50
+	spec := make([][]int, n)
+	for i := range spec {
+		for j := 0; j < n; j++ {
+			if j != i {
+				spec[i] = append(spec[i], j)
+			}
+		}
+	}
+	g := MakeTestGraph(spec)
+
+	cycles := g.RemoveElementaryCycles()
+	a.NotEmpty(cycles)
+	a.Empty(g.Tangles(0))
+	for _, cycle := range cycles {
+		a.False(g.HasEdgeFromTo(hashCached(cycle.Cause[0]), hashCached(cycle.Cause[1])))
+	}
+}
@@ -13,7 +13,9 @@ func MakeTestGraph(spec [][]int) *Graph[int] {
 
 	var queue deque.Deque[*Node[int]]
 	node := MakeNode("0", 0)
-	g.AddNode(node)
+	if err := g.AddNode(node); err != nil {
+		panic(err)
+	}
 	queue.PushBack(node)
 	visited := make(map[string]bool)
 
@@ -30,9 +32,9 @@ func MakeTestGraph(spec [][]int) *Graph[int] {
 			depNode := g.Get(depId)
 			if depNode == nil {
 				depNode = MakeNode(strconv.Itoa(dep), dep)
-				g.AddNode(depNode)
-			} else {
-
+				if err := g.AddNode(depNode); err != nil {
+					panic(err)
+				}
 			}
 			err := g.AddFromToEdge(node.Id, depNode.Id)
 			if err != nil {
@@ -0,0 +1,14 @@
+package com.example;
+
+import com.example.model.*;
+import com.example.util.Strings;
+import static com.example.util.Numbers.sum;
+import java.util.List;
+
+public class App {
+    public static void main(String[] args) {
+        User user = new User("foo");
+        Config config = new Config();
+        System.out.println(Strings.upper(user.name()) + sum(1, 2));
+    }
+}
@@ -0,0 +1,5 @@
+package com.example;
+
+class Config {
+    String name = "App";
+}
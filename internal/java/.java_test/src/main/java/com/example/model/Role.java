@@ -0,0 +1,6 @@
+package com.example.model;
+
+public enum Role {
+    ADMIN,
+    GUEST
+}
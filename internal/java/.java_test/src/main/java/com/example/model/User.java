@@ -0,0 +1,7 @@
+package com.example.model;
+
+public record User(String name) {
+    Role role() {
+        return Role.ADMIN;
+    }
+}
@@ -0,0 +1,11 @@
+package com.example.util;
+
+public class Numbers {
+    public static int sum(int a, int b) {
+        return a + b;
+    }
+
+    static class Inner {}
+}
+
+class Helper {}
@@ -0,0 +1,10 @@
+package com.example;
+
+import com.example.util.Numbers.Inner;
+import static com.example.util.Numbers.*;
+
+public class AppTest {
+    void test() {
+        App.main(new String[]{});
+    }
+}
@@ -0,0 +1,5 @@
+package java
+
+type Config struct {
+	SourceRoots []string `yaml:"sourceRoots"`
+}
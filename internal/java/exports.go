@@ -0,0 +1,24 @@
+package java
+
+import (
+	"github.com/gabotechs/dep-tree/internal/java/java_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	exports := make([]language.ExportEntry, 0)
+
+	content := file.Content.(*java_grammar.File)
+	for _, stmt := range content.Statements {
+		if stmt.Type != nil && stmt.Type.Public() {
+			exports = append(exports, language.ExportEntry{
+				Symbols: []language.ExportSymbol{{Original: stmt.Type.Name}},
+				AbsPath: file.AbsPath,
+			})
+		}
+	}
+
+	return &language.ExportsResult{
+		Exports: exports,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package java
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func TestLanguage_ParseExports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+	mainDir := filepath.Join(absTestFolder, "src", "main", "java", "com", "example")
+
+	tests := []struct {
+		Name     string
+		Expected []language.ExportEntry
+	}{
+		{
+			Name: filepath.Join("util", "Numbers.java"),
+			Expected: []language.ExportEntry{
+				{
+					Symbols: []language.ExportSymbol{{Original: "Numbers"}},
+					AbsPath: filepath.Join(mainDir, "util", "Numbers.java"),
+				},
+			},
+		},
+		{
+			Name:     "Config.java",
+			Expected: []language.ExportEntry{},
+		},
+		{
+			Name: filepath.Join("model", "User.java"),
+			Expected: []language.ExportEntry{
+				{
+					Symbols: []language.ExportSymbol{{Original: "User"}},
+					AbsPath: filepath.Join(mainDir, "model", "User.java"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeJavaLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(filepath.Join(mainDir, tt.Name))
+			a.NoError(err)
+
+			exports, err := lang.ParseExports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, exports.Exports)
+		})
+	}
+}
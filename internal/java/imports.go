@@ -0,0 +1,100 @@
+package java
+
+import (
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/java/java_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+//nolint:gocyclo
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	imports := make([]language.ImportEntry, 0)
+	var errors []error
+
+	content := file.Content.(*java_grammar.File)
+	roots := l.sourceRoots(file.AbsPath, content.Package())
+
+	// 1. Explicit imports. Single type imports shadow the types with the same name
+	//    declared in the current package, so keep track of them.
+	explicit := map[string]struct{}{}
+	var wildcardPackages []string
+	for _, stmt := range content.Statements {
+		if stmt.Import == nil {
+			continue
+		}
+		imp := stmt.Import
+		start := len(imports)
+		switch {
+		case imp.All && !imp.Static:
+			// `import com.example.*;` might import a whole package, or all the nested
+			// types of a class.
+			if dirs := resolvePackage(imp.Path, roots); len(dirs) > 0 {
+				wildcardPackages = append(wildcardPackages, dirs...)
+			} else if absPath, name := resolveType(imp.Path, roots); absPath != "" {
+				imports = append(imports, language.SymbolsImport([]string{name}, absPath))
+			}
+		case imp.Static:
+			// `import static com.example.Foo.bar;` and `import static com.example.Foo.*;`
+			// depend on the class that holds the static members.
+			path := imp.Path
+			if !imp.All && len(path) > 1 {
+				path = path[:len(path)-1]
+			}
+			if absPath, name := resolveType(path, roots); absPath != "" {
+				imports = append(imports, language.SymbolsImport([]string{name}, absPath))
+			}
+		default:
+			if absPath, name := resolveType(imp.Path, roots); absPath != "" {
+				imports = append(imports, language.SymbolsImport([]string{name}, absPath))
+				explicit[imp.Path[len(imp.Path)-1]] = struct{}{}
+			}
+		}
+		for i := start; i < len(imports); i++ {
+			imports[i].Line, imports[i].Column = imp.Pos.Line, imp.Pos.Column
+		}
+	}
+
+	// 2. Types from the same package and from wildcard imported packages are referenced
+	//    without naming them anywhere in the import statements, so match the identifiers
+	//    used in the file against the types declared in those packages.
+	thisPackage := []string{filepath.Dir(file.AbsPath)}
+	if pkg := content.Package(); pkg != nil {
+		if dirs := resolvePackage(pkg.Path, roots); len(dirs) > 0 {
+			thisPackage = dirs
+		}
+	}
+	pkgLookup := append(thisPackage, wildcardPackages...)
+	for _, ident := range content.Idents {
+		if _, ok := explicit[ident]; ok {
+			continue
+		}
+		for i, dir := range pkgLookup {
+			types, err := typesInDir(dir)
+			if err != nil {
+				errors = append(errors, err)
+				continue
+			}
+			declared, ok := types[ident]
+			if !ok || declared.AbsPath == file.AbsPath {
+				continue
+			}
+			switch {
+			case declared.Public:
+				imports = append(imports, language.SymbolsImport([]string{ident}, declared.AbsPath))
+			case i < len(thisPackage):
+				// Package-private types are not exported, but they are still
+				// visible from the same package.
+				imports = append(imports, language.EmptyImport(declared.AbsPath))
+			default:
+				continue
+			}
+			break
+		}
+	}
+
+	return &language.ImportsResult{
+		Imports: imports,
+		Errors:  errors,
+	}, nil
+}
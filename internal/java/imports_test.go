@@ -0,0 +1,77 @@
+package java
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".java_test"
+
+func TestLanguage_ParseImports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+	mainDir := filepath.Join(absTestFolder, "src", "main", "java", "com", "example")
+	testDir := filepath.Join(absTestFolder, "src", "test", "java", "com", "example")
+
+	tests := []struct {
+		Name     string
+		File     string
+		Expected []language.ImportEntry
+	}{
+		{
+			Name: "App.java",
+			File: filepath.Join(mainDir, "App.java"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"Strings"}, filepath.Join(mainDir, "util", "Strings.java")), 4, 1),
+				at(language.SymbolsImport([]string{"Numbers"}, filepath.Join(mainDir, "util", "Numbers.java")), 5, 1),
+				language.SymbolsImport([]string{"User"}, filepath.Join(mainDir, "model", "User.java")),
+				language.EmptyImport(filepath.Join(mainDir, "Config.java")),
+			},
+		},
+		{
+			Name: "User.java",
+			File: filepath.Join(mainDir, "model", "User.java"),
+			Expected: []language.ImportEntry{
+				language.SymbolsImport([]string{"Role"}, filepath.Join(mainDir, "model", "Role.java")),
+			},
+		},
+		{
+			Name:     "Numbers.java",
+			File:     filepath.Join(mainDir, "util", "Numbers.java"),
+			Expected: []language.ImportEntry{},
+		},
+		{
+			Name: "AppTest.java",
+			File: filepath.Join(testDir, "AppTest.java"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"Numbers"}, filepath.Join(mainDir, "util", "Numbers.java")), 3, 1),
+				at(language.SymbolsImport([]string{"Numbers"}, filepath.Join(mainDir, "util", "Numbers.java")), 4, 1),
+				language.SymbolsImport([]string{"App"}, filepath.Join(mainDir, "App.java")),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeJavaLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			imports, err := lang.ParseImports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, imports.Imports)
+			a.Nil(imports.Errors)
+		})
+	}
+}
+
+func at(entry language.ImportEntry, line, column int) language.ImportEntry {
+	entry.Line, entry.Column = line, column
+	return entry
+}
@@ -0,0 +1,110 @@
+//nolint:govet
+package java_grammar
+
+import (
+	"bytes"
+	"os"
+	"unicode"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+type Statement struct {
+	Package *Package `  @@`
+	Import  *Import  `| @@`
+	Type    *Type    `| @@`
+	// Block swallows anything between braces, so that only top level
+	// declarations are matched as statements.
+	Block *Block `| @@`
+}
+
+type Block struct {
+	Blocks []*Block `"{" (@@ | ANY | ALL | Punct | Ident | String)* "}"`
+}
+
+type File struct {
+	Statements []*Statement `(@@ | ANY | ALL | Punct | Ident | String | Brace)*`
+	// Idents are the capitalized identifiers referenced in the file, in order of
+	// appearance and without duplicates. Java resolves types from the same package
+	// or from wildcard imports without naming the file, so these are used for
+	// matching them against the types declared in other files.
+	Idents []string
+}
+
+var (
+	lex = lexer.MustSimple(
+		[]lexer.SimpleRule{
+			{"ALL", `\*`},
+			{"Brace", `[{}]`},
+			{"Punct", `[.;,@()<>=-]`},
+			{"Ident", `[_$a-zA-Z][_$a-zA-Z0-9]*`},
+			{"String", `"""(.|\n)*?"""` + "|" + `"(?:\\.|[^"])*"` + "|" + `'(?:\\.|[^'])*'`},
+			{"Comment", `//.*|/\*(.|\n)*?\*/`},
+			{"Whitespace", `\s+`},
+			{"ANY", `.`},
+		},
+	)
+	parser = participle.MustBuild[File](
+		participle.Lexer(lex),
+		participle.Elide("Whitespace", "Comment"),
+		participle.UseLookahead(1024),
+	)
+)
+
+// Package returns the package declared in the file, or nil if the file
+// belongs to the unnamed package.
+func (f *File) Package() *Package {
+	for _, stmt := range f.Statements {
+		if stmt.Package != nil {
+			return stmt.Package
+		}
+	}
+	return nil
+}
+
+func collectIdents(filePath string, content []byte) ([]string, error) {
+	l, err := lex.Lex(filePath, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := lexer.ConsumeAll(l)
+	if err != nil {
+		return nil, err
+	}
+	identType := lex.Symbols()["Ident"]
+	seen := map[string]struct{}{}
+	var idents []string
+	for _, token := range tokens {
+		if token.Type != identType || !unicode.IsUpper(rune(token.Value[0])) {
+			continue
+		}
+		if _, ok := seen[token.Value]; !ok {
+			seen[token.Value] = struct{}{}
+			idents = append(idents, token.Value)
+		}
+	}
+	return idents, nil
+}
+
+func Parse(filePath string) (*language.FileInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseBytes(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	file.Idents, err = collectIdents(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	return &language.FileInfo{
+		Content: file,
+		Loc:     bytes.Count(content, []byte("\n")),
+		Size:    len(content),
+		AbsPath: filePath,
+	}, nil
+}
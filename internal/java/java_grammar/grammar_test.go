@@ -0,0 +1,131 @@
+package java_grammar
+
+import (
+	"testing"
+
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		Name            string
+		ExpectedPackage string
+		ExpectedImports []Import
+		ExpectedTypes   []string
+		ExpectedIdents  []string
+	}{
+		{
+			Name:            "package com.example;",
+			ExpectedPackage: "com.example",
+		},
+		{
+			Name:            "import com.example.Foo;",
+			ExpectedImports: []Import{{Path: []string{"com", "example", "Foo"}}},
+			ExpectedIdents:  []string{"Foo"},
+		},
+		{
+			Name:            "import com.example.*;",
+			ExpectedImports: []Import{{Path: []string{"com", "example"}, All: true}},
+		},
+		{
+			Name:            "import static com.example.Foo.bar;",
+			ExpectedImports: []Import{{Static: true, Path: []string{"com", "example", "Foo", "bar"}}},
+			ExpectedIdents:  []string{"Foo"},
+		},
+		{
+			Name:            "import static com.example.Foo.*;",
+			ExpectedImports: []Import{{Static: true, Path: []string{"com", "example", "Foo"}, All: true}},
+			ExpectedIdents:  []string{"Foo"},
+		},
+		{
+			Name:           "public class Foo {}",
+			ExpectedTypes:  []string{"public class Foo"},
+			ExpectedIdents: []string{"Foo"},
+		},
+		{
+			Name:           "final public class Foo<T extends Bar> extends Baz {}",
+			ExpectedTypes:  []string{"public class Foo"},
+			ExpectedIdents: []string{"Foo", "T", "Bar", "Baz"},
+		},
+		{
+			Name:           "interface Foo { class Inner {} }",
+			ExpectedTypes:  []string{"interface Foo"},
+			ExpectedIdents: []string{"Foo", "Inner"},
+		},
+		{
+			Name:           "@Deprecated\npublic enum Foo { A, B; static class Inner { void f() { if (x) {} } } }\nrecord Bar(int x) {}",
+			ExpectedTypes:  []string{"public enum Foo", "record Bar"},
+			ExpectedIdents: []string{"Deprecated", "Foo", "A", "B", "Inner", "Bar"},
+		},
+		{
+			Name:           "public @interface Foo {}",
+			ExpectedTypes:  []string{"public @interface Foo"},
+			ExpectedIdents: []string{"Foo"},
+		},
+		{
+			Name:           "public non-sealed class Foo {}",
+			ExpectedTypes:  []string{"public class Foo"},
+			ExpectedIdents: []string{"Foo"},
+		},
+		{
+			Name:           "// public class Foo {}\n/* class Bar {} */ String s = \"class Baz {}\";",
+			ExpectedIdents: []string{"String"},
+		},
+		{
+			Name:            "package a.b;\n\nimport a.c.D;\n\npublic class E { D d = new D(\"{\"); }",
+			ExpectedPackage: "a.b",
+			ExpectedImports: []Import{{Path: []string{"a", "c", "D"}}},
+			ExpectedTypes:   []string{"public class E"},
+			ExpectedIdents:  []string{"D", "E"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			parsed, err := parser.ParseBytes("", []byte(tt.Name))
+			a.NoError(err)
+			idents, err := collectIdents("", []byte(tt.Name))
+			a.NoError(err)
+
+			var pkg string
+			if p := parsed.Package(); p != nil {
+				pkg = p.String()
+			}
+			var imports []Import
+			var types []string
+			for _, stmt := range parsed.Statements {
+				switch {
+				case stmt.Import != nil:
+					imported := *stmt.Import
+					imported.Pos = lexer.Position{}
+					imports = append(imports, imported)
+				case stmt.Type != nil:
+					name := stmt.Type.Kind + " " + stmt.Type.Name
+					if stmt.Type.Public() {
+						name = "public " + name
+					}
+					types = append(types, name)
+				}
+			}
+			a.Equal(tt.ExpectedPackage, pkg)
+			a.Equal(tt.ExpectedImports, imports)
+			a.Equal(tt.ExpectedTypes, types)
+			a.Equal(tt.ExpectedIdents, idents)
+		})
+	}
+}
+
+func TestType_Abstract(t *testing.T) {
+	a := require.New(t)
+	parsed, err := parser.ParseBytes("", []byte("public interface A {}\npublic abstract class B {}\n@interface C {}\nfinal class D {}\nenum E {}"))
+	a.NoError(err)
+	var abstract []bool
+	for _, stmt := range parsed.Statements {
+		if stmt.Type != nil {
+			abstract = append(abstract, stmt.Type.Abstract())
+		}
+	}
+	a.Equal([]bool{true, true, true, false, false}, abstract)
+}
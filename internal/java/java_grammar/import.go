@@ -0,0 +1,11 @@
+//nolint:govet
+package java_grammar
+
+import "github.com/alecthomas/participle/v2/lexer"
+
+type Import struct {
+	Pos    lexer.Position
+	Static bool     `"import" @"static"?`
+	Path   []string `@Ident ("." @Ident)*`
+	All    bool     `("." @ALL)? ";"`
+}
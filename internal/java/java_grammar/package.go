@@ -0,0 +1,12 @@
+//nolint:govet
+package java_grammar
+
+import "strings"
+
+type Package struct {
+	Path []string `"package" @Ident ("." @Ident)* ";"`
+}
+
+func (p *Package) String() string {
+	return strings.Join(p.Path, ".")
+}
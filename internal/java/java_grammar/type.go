@@ -0,0 +1,30 @@
+//nolint:govet
+package java_grammar
+
+type Type struct {
+	Modifiers []string `(@("public" | "protected" | "private" | "abstract" | "final" | "static" | "sealed" | "strictfp") | "non" "-" "sealed")*`
+	Kind      string   `@("class" | "interface" | "enum" | "record" | "@" "interface")`
+	Name      string   `@Ident`
+}
+
+// Abstract tells if the type cannot be instantiated, like interfaces or abstract classes.
+func (t *Type) Abstract() bool {
+	if t.Kind == "interface" || t.Kind == "@interface" {
+		return true
+	}
+	for _, modifier := range t.Modifiers {
+		if modifier == "abstract" {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Type) Public() bool {
+	for _, modifier := range t.Modifiers {
+		if modifier == "public" {
+			return true
+		}
+	}
+	return false
+}
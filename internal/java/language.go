@@ -0,0 +1,74 @@
+package java
+
+import (
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/java/java_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var Extensions = []string{
+	"java",
+}
+
+type Language struct {
+	cfg *Config
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+
+func MakeJavaLanguage(cfg *Config) (language.Language, error) {
+	lang := Language{
+		cfg: cfg,
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+	return &lang, nil
+}
+
+var parseJavaFile, evictJavaFile = utils.EvictableCached1In1OutErr(java_grammar.Parse)
+
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	parsed, err := parseJavaFile(id)
+	if err != nil {
+		return nil, err
+	}
+	// the parsed file is shared by all the callers, so the fields that depend on where
+	// the file is are filled in a copy of it.
+	file := *parsed
+	content := file.Content.(*java_grammar.File)
+	if pkg := content.Package(); pkg != nil {
+		file.Package = pkg.String()
+	}
+	if projectRoot := findProjectRoot(filepath.Dir(id)); projectRoot != nil {
+		file.RelPath, _ = filepath.Rel(projectRoot.AbsDir, id)
+	} else {
+		file.RelPath, _ = filepath.Rel(packageRoot(id, content.Package()), id)
+	}
+	file.Types, file.AbstractTypes = countTypes(content)
+	return &file, nil
+}
+
+// Invalidate drops the provided files and the type index of the packages where they live.
+func (l *Language) Invalidate(absPaths ...string) {
+	evictJavaFile(utils.IsAnyOf(absPaths))
+	evictTypesInDir(utils.IsDirOfAnyOf(absPaths))
+}
+
+// countTypes returns how many types are declared at the top level of the file, and how
+// many of them are interfaces or abstract classes.
+func countTypes(file *java_grammar.File) (int, int) {
+	types, abstractTypes := 0, 0
+	for _, stmt := range file.Statements {
+		if stmt.Type != nil {
+			types++
+			if stmt.Type.Abstract() {
+				abstractTypes++
+			}
+		}
+	}
+	return types, abstractTypes
+}
@@ -0,0 +1,56 @@
+package java
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguage_ParseFile(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Path            string
+		ExpectedRelPath string
+		ExpectedPackage string
+		ExpectedTypes   int
+	}{
+		{
+			Name:            "main source root",
+			Path:            filepath.Join(testFolder, "src", "main", "java", "com", "example", "util", "Strings.java"),
+			ExpectedRelPath: "src/main/java/com/example/util/Strings.java",
+			ExpectedPackage: "com.example.util",
+			ExpectedTypes:   1,
+		},
+		{
+			Name:            "test source root",
+			Path:            filepath.Join(testFolder, "src", "test", "java", "com", "example", "AppTest.java"),
+			ExpectedRelPath: "src/test/java/com/example/AppTest.java",
+			ExpectedPackage: "com.example",
+			ExpectedTypes:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeJavaLanguage(nil)
+			a.NoError(err)
+			absPath, _ := filepath.Abs(tt.Path)
+			file, err := lang.ParseFile(absPath)
+			a.NoError(err)
+			a.Equal(tt.ExpectedPackage, file.Package)
+			a.Equal(tt.ExpectedRelPath, file.RelPath)
+			a.Equal(tt.ExpectedTypes, file.Types)
+			// parsing the same file again must not count its types twice, nor modify
+			// the file returned before.
+			again, err := lang.ParseFile(absPath)
+			a.NoError(err)
+			a.Equal(tt.ExpectedTypes, again.Types)
+			a.NotSame(file, again)
+			parsed, err := parseJavaFile(absPath)
+			a.NoError(err)
+			a.Empty(parsed.RelPath)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package java
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/java/java_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// mavenSourceRoots are the conventional Maven/Gradle directories, relative to
+// the project root, where Java sources are placed.
+var mavenSourceRoots = []string{
+	filepath.Join("src", "main", "java"),
+	filepath.Join("src", "test", "java"),
+}
+
+var findProjectRoot = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	"pom.xml",
+	"build.gradle",
+	"build.gradle.kts",
+})
+
+// packageRoot infers the source root of a file based on its package declaration,
+// e.g. /project/src/main/java/com/example/App.java declaring `package com.example;`
+// has /project/src/main/java as its source root.
+func packageRoot(absPath string, pkg *java_grammar.Package) string {
+	dir := filepath.Dir(absPath)
+	if pkg == nil {
+		return dir
+	}
+	pkgDir := filepath.Join(pkg.Path...)
+	if strings.HasSuffix(dir, string(os.PathSeparator)+pkgDir) {
+		return strings.TrimSuffix(dir, string(os.PathSeparator)+pkgDir)
+	}
+	return dir
+}
+
+// sourceRoots returns the directories against which fully qualified names referenced
+// in the provided file are resolved, in order of preference.
+func (l *Language) sourceRoots(absPath string, pkg *java_grammar.Package) []string {
+	roots := []string{packageRoot(absPath, pkg)}
+	if projectRoot := findProjectRoot(filepath.Dir(absPath)); projectRoot != nil {
+		for _, root := range mavenSourceRoots {
+			roots = append(roots, filepath.Join(projectRoot.AbsDir, root))
+		}
+	}
+	for _, root := range l.cfg.SourceRoots {
+		if abs, err := filepath.Abs(root); err == nil {
+			roots = append(roots, abs)
+		}
+	}
+
+	seen := map[string]struct{}{}
+	result := make([]string, 0, len(roots))
+	for _, root := range roots {
+		if _, ok := seen[root]; ok || !utils.DirExists(root) {
+			continue
+		}
+		seen[root] = struct{}{}
+		result = append(result, root)
+	}
+	return result
+}
+
+// resolveType resolves a fully qualified type name, like ["com", "example", "Foo"], to
+// the file where it's declared. Nested types, like ["com", "example", "Foo", "Inner"],
+// resolve to the file of the outermost type. It returns the absolute path of the file
+// and the top level type name, or empty strings if nothing was found.
+func resolveType(path []string, roots []string) (string, string) {
+	for _, root := range roots {
+		for i := len(path); i > 0; i-- {
+			candidate := filepath.Join(append([]string{root}, path[:i]...)...) + ".java"
+			if utils.FileExists(candidate) {
+				return candidate, path[i-1]
+			}
+		}
+	}
+	return "", ""
+}
+
+// resolvePackage resolves a package name, like ["com", "example"], to the directories
+// that hold its files. A package might be split across several source roots, for
+// example, src/main/java and src/test/java.
+func resolvePackage(path []string, roots []string) []string {
+	var result []string
+	for _, root := range roots {
+		candidate := filepath.Join(append([]string{root}, path...)...)
+		if utils.DirExists(candidate) {
+			result = append(result, candidate)
+		}
+	}
+	return result
+}
+
+type declaredType struct {
+	AbsPath string
+	Public  bool
+}
+
+// _typesInDir indexes the top level types declared by the Java files in a dir,
+// which is the same as indexing the types declared in a package.
+func _typesInDir(dir string) (map[string]declaredType, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]declaredType{}
+	for _, entry := range entries {
+		if entry.IsDir() || !utils.EndsWith(entry.Name(), Extensions) {
+			continue
+		}
+		absPath := filepath.Join(dir, entry.Name())
+		file, err := parseJavaFile(absPath)
+		if err != nil {
+			// A broken file in the package should not prevent resolving the rest.
+			continue
+		}
+		for _, stmt := range file.Content.(*java_grammar.File).Statements {
+			if stmt.Type != nil {
+				result[stmt.Type.Name] = declaredType{AbsPath: absPath, Public: stmt.Type.Public()}
+			}
+		}
+	}
+	return result, nil
+}
+
+var typesInDir, evictTypesInDir = utils.EvictableCached1In1OutErr(_typesInDir)
@@ -0,0 +1,2 @@
+// @ts-ignore
+import App from './App.vue'
@@ -21,6 +21,7 @@ func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResul
 			continue
 		case stmt.StaticImport != nil:
 			importPath = stmt.StaticImport.Path
+			entry.TypeOnly = stmt.StaticImport.TypeOnly
 			if imported := stmt.StaticImport.Imported; imported != nil {
 				if imported.Default {
 					entry.Symbols = append(entry.Symbols, "default")
@@ -46,6 +47,7 @@ func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResul
 		default:
 			continue
 		}
+		entry.Line, entry.Column = stmt.Pos.Line, stmt.Pos.Column
 		var err error
 		entry.AbsPath, err = l.ResolvePath(importPath, filepath.Dir(file.AbsPath))
 		if err != nil {
@@ -25,19 +25,41 @@ func TestParser_parseImports(t *testing.T) {
 			Name: "test 1",
 			File: filepath.Join(importsTestFolder, "index.ts"),
 			Expected: []language.ImportEntry{
-				{Symbols: []string{"a", "b"}, AbsPath: filepath.Join(wd, importsTestFolder, "2", "2.ts")},
-				{All: true, AbsPath: filepath.Join(wd, importsTestFolder, "2", "index.ts")},
-				{All: true, AbsPath: filepath.Join(wd, importsTestFolder, "1", "a", "a.ts")},
-				{All: true, AbsPath: filepath.Join(wd, importsTestFolder, "1", "a", "index.ts")},
-				{Symbols: []string{"Unexisting"}, AbsPath: filepath.Join(wd, importsTestFolder, "1", "a", "index.ts")},
-				{All: true, AbsPath: filepath.Join(wd, importsTestFolder, "2", "2.ts")},
-				{Symbols: []string{"a", "b"}, AbsPath: filepath.Join(wd, importsTestFolder, "2", "2.ts")},
-				{AbsPath: filepath.Join(wd, importsTestFolder, "1", "a", "index.ts")},
+				{Symbols: []string{"a", "b"}, AbsPath: filepath.Join(wd, importsTestFolder, "2", "2.ts"), Line: 2, Column: 1},
+				{All: true, AbsPath: filepath.Join(wd, importsTestFolder, "2", "index.ts"), Line: 3, Column: 1},
+				{All: true, AbsPath: filepath.Join(wd, importsTestFolder, "1", "a", "a.ts"), Line: 4, Column: 1},
+				{All: true, AbsPath: filepath.Join(wd, importsTestFolder, "1", "a", "index.ts"), Line: 6, Column: 1},
+				{Symbols: []string{"Unexisting"}, AbsPath: filepath.Join(wd, importsTestFolder, "1", "a", "index.ts"), Line: 10, Column: 1},
+				{All: true, AbsPath: filepath.Join(wd, importsTestFolder, "2", "2.ts"), Line: 11, Column: 1},
+				{Symbols: []string{"a", "b"}, AbsPath: filepath.Join(wd, importsTestFolder, "2", "2.ts"), Line: 12, Column: 1},
+				{AbsPath: filepath.Join(wd, importsTestFolder, "1", "a", "index.ts"), Line: 13, Column: 1},
 			},
 			ExpectedErrors: []string{
 				"could not perform relative import for './unexisting'",
 			},
 		},
+		{
+			Name: "vue and svelte components",
+			File: filepath.Join(importsTestFolder, "3", "index.ts"),
+			Expected: []language.ImportEntry{
+				{Symbols: []string{"default"}, AbsPath: filepath.Join(wd, importsTestFolder, "3", "App.vue"), Line: 2, Column: 1},
+			},
+		},
+		{
+			Name: "vue script blocks",
+			File: filepath.Join(importsTestFolder, "3", "App.vue"),
+			Expected: []language.ImportEntry{
+				{Symbols: []string{"default"}, AbsPath: filepath.Join(wd, importsTestFolder, "3", "Child.svelte"), Line: 13, Column: 1},
+				{Symbols: []string{"a"}, AbsPath: filepath.Join(wd, importsTestFolder, "2", "2.ts"), Line: 14, Column: 1},
+			},
+		},
+		{
+			Name: "svelte script blocks",
+			File: filepath.Join(importsTestFolder, "3", "Child.svelte"),
+			Expected: []language.ImportEntry{
+				{All: true, AbsPath: filepath.Join(wd, importsTestFolder, "1", "a", "index.ts"), Line: 6, Column: 3},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -13,6 +13,7 @@ import (
 )
 
 type Statement struct {
+	Pos lexer.Position
 	// imports.
 	DynamicImport *DynamicImport `  @@`
 	StaticImport  *StaticImport  `| @@`
@@ -54,7 +55,11 @@ func Parse(filePath string) (*language.FileInfo, error) {
 	if err != nil {
 		return nil, err
 	}
-	statements, err := parser.ParseBytes(filePath, content)
+	code := content
+	if isSFC(filePath) {
+		code = extractScripts(content)
+	}
+	statements, err := parser.ParseBytes(filePath, code)
 	if err != nil {
 		return nil, err
 	}
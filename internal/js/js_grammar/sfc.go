@@ -0,0 +1,38 @@
+package js_grammar
+
+import (
+	"regexp"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// SFCExtensions are the extensions of single-file components, like Vue or Svelte
+// ones, where the JS code lives inside <script> blocks.
+var SFCExtensions = []string{
+	"vue",
+	"svelte",
+}
+
+var scriptRegex = regexp.MustCompile(`(?is)<script\b[^>]*>(.*?)</script\s*>`)
+
+// extractScripts blanks out everything in an SFC that is not the content of a
+// <script> or <script setup> block. Newlines are preserved, so positions in the
+// returned code still match the ones of the original file.
+func extractScripts(content []byte) []byte {
+	result := make([]byte, len(content))
+	for i, b := range content {
+		if b == '\n' {
+			result[i] = '\n'
+		} else {
+			result[i] = ' '
+		}
+	}
+	for _, match := range scriptRegex.FindAllSubmatchIndex(content, -1) {
+		copy(result[match[2]:match[3]], content[match[2]:match[3]])
+	}
+	return result
+}
+
+func isSFC(filePath string) bool {
+	return utils.EndsWith(filePath, SFCExtensions)
+}
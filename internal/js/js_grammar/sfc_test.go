@@ -0,0 +1,48 @@
+package js_grammar
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func blank(s string) string {
+	return strings.Repeat(" ", len(s))
+}
+
+func TestExtractScripts(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name:    "vue",
+			Content: "<template>\n  <p>import x from 'y'</p>\n</template>\n<script setup lang=\"ts\">\nimport a from './a'\n</script>",
+			Expected: blank("<template>") + "\n" +
+				blank("  <p>import x from 'y'</p>") + "\n" +
+				blank("</template>") + "\n" +
+				blank("<script setup lang=\"ts\">") + "\n" +
+				"import a from './a'\n" +
+				blank("</script>"),
+		},
+		{
+			Name:     "multiple scripts",
+			Content:  "<script context=\"module\">a</script><SCRIPT>b</SCRIPT >",
+			Expected: blank("<script context=\"module\">") + "a" + blank("</script><SCRIPT>") + "b" + blank("</SCRIPT >"),
+		},
+		{
+			Name:     "no scripts",
+			Content:  "<style>.a { color: red }</style>",
+			Expected: blank("<style>.a { color: red }</style>"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			a.Equal(tt.Expected, string(extractScripts([]byte(tt.Content))))
+		})
+	}
+}
@@ -8,34 +8,36 @@ import (
 	"github.com/gabotechs/dep-tree/internal/utils"
 )
 
-var Extensions = []string{
+var Extensions = append([]string{
 	"js", "ts", "tsx", "jsx", "d.ts", "mjs", "cjs",
-}
+}, js_grammar.SFCExtensions...)
 
 type Language struct {
 	Cfg *Config
 }
 
 var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
 
-var findFirstPackageJsonWithNameCache = map[string]*packageJson{}
+var findFirstPackageJsonWithName func(searchPath string) *packageJson
+var evictFirstPackageJsonWithName utils.Evict[string]
 
-func findFirstPackageJsonWithName(searchPath string) *packageJson {
-	if result, ok := findFirstPackageJsonWithNameCache[searchPath]; ok {
-		return result
-	}
-	packageJsonPath := filepath.Join(searchPath, packageJsonFile)
-	if utils.FileExists(packageJsonPath) {
-		pckJson, _ := readPackageJson(packageJsonPath)
-		if pckJson != nil && pckJson.Name != "" {
-			return pckJson
+func init() {
+	// it's cached recursively, so that every directory in the way up is cached.
+	findFirstPackageJsonWithName, evictFirstPackageJsonWithName = utils.EvictableCached1In1Out(func(searchPath string) *packageJson {
+		packageJsonPath := filepath.Join(searchPath, packageJsonFile)
+		if utils.FileExists(packageJsonPath) {
+			pckJson, _ := readPackageJson(packageJsonPath)
+			if pckJson != nil && pckJson.Name != "" {
+				return pckJson
+			}
 		}
-	}
-	nextSearchPath := filepath.Dir(searchPath)
-	if nextSearchPath != searchPath {
-		findFirstPackageJsonWithNameCache[searchPath] = findFirstPackageJsonWithName(nextSearchPath)
-	}
-	return findFirstPackageJsonWithNameCache[searchPath]
+		nextSearchPath := filepath.Dir(searchPath)
+		if nextSearchPath != searchPath {
+			return findFirstPackageJsonWithName(nextSearchPath)
+		}
+		return nil
+	})
 }
 
 func MakeJsLanguage(cfg *Config) (language.Language, error) {
@@ -55,3 +57,22 @@ func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
 	fileInfo.RelPath, _ = filepath.Rel(pkgJson.absPath, id)
 	return fileInfo, nil
 }
+
+// Invalidate drops the package.json files that are among the provided files. As they
+// are looked up from every dir above the source files, and workspaces are made of all
+// the package.json files below the root one, any of them changing drops all the lookups.
+func (l *Language) Invalidate(absPaths ...string) {
+	isAnyOf := utils.IsAnyOf(absPaths)
+	evictPackageJson(func(path string) bool {
+		return isAnyOf(path) || isAnyOf(filepath.Join(path, packageJsonFile))
+	})
+	for _, absPath := range absPaths {
+		if filepath.Base(absPath) == packageJsonFile {
+			all := func(string) bool { return true }
+			evictWorkspaces(all)
+			evictClosestPackageJsonPath(all)
+			evictFirstPackageJsonWithName(all)
+			break
+		}
+	}
+}
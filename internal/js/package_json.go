@@ -18,7 +18,7 @@ type packageJson struct {
 	Workspaces interface{} `json:"workspaces"`
 }
 
-var readPackageJson = utils.Cached1In2Out(func(path string) (*packageJson, error) {
+var readPackageJson, evictPackageJson = utils.EvictableCached1In2Out(func(path string) (*packageJson, error) {
 	path, err := filepath.Abs(path)
 	if err != nil {
 		return nil, err
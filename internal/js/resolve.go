@@ -135,4 +135,4 @@ func _findClosestPackageJsonPath(searchPath string) string {
 	}
 }
 
-var findClosestPackageJsonPath = utils.Cached1In1Out(_findClosestPackageJsonPath)
+var findClosestPackageJsonPath, evictClosestPackageJsonPath = utils.EvictableCached1In1Out(_findClosestPackageJsonPath)
@@ -52,7 +52,7 @@ func searchFirstPackageJsonWithWorkspaces(searchPath string) (*packageJson, erro
 	return nil, nil
 }
 
-var NewWorkspaces = utils.Cached1In1OutErr(func(searchPath string) (*Workspaces, error) {
+var NewWorkspaces, evictWorkspaces = utils.EvictableCached1In1OutErr(func(searchPath string) (*Workspaces, error) {
 	searchPath, err := filepath.Abs(searchPath)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,8 @@
+plugins {
+    kotlin("jvm")
+}
+
+dependencies {
+    implementation(project(":core:data"))
+    implementation(project(":core:ui"))
+}
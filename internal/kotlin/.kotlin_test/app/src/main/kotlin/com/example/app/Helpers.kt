@@ -0,0 +1,3 @@
+package com.example.app
+
+internal fun helper() = println("help")
@@ -0,0 +1,14 @@
+package com.example.app
+
+import com.example.data.Repository as Repo
+import com.example.data.format
+import com.example.ui.*
+
+fun main() {
+    val repo = Repo()
+    val user = repo.find()
+    println(user.format())
+    helper()
+    Extra()
+    Widget(user, null)
+}
@@ -0,0 +1,3 @@
+package com.example.data
+
+class Generated
@@ -0,0 +1,5 @@
+package com.example.data
+
+fun User.format(): String = name
+
+fun format(value: Int): String = value.toString()
@@ -0,0 +1,7 @@
+package com.example.data
+
+class Repository {
+    fun find(): User = User(hidden())
+}
+
+private fun hidden() = "name"
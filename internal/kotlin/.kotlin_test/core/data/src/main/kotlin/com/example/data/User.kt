@@ -0,0 +1,5 @@
+package com.example.data
+
+data class User(val name: String)
+
+internal class Secret
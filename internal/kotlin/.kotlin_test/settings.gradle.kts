@@ -0,0 +1,6 @@
+rootProject.name = "sample"
+
+include(":app")
+include(":core:data", ":core:ui")
+
+project(":core:ui").projectDir = file("ui")
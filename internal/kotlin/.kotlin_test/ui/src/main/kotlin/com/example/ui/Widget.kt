@@ -0,0 +1,5 @@
+package com.example.ui
+
+import com.example.data.*
+
+class Widget(val user: User, val secret: Secret?)
@@ -0,0 +1,3 @@
+package kotlin
+
+type Config struct{}
@@ -0,0 +1,34 @@
+package kotlin
+
+import (
+	"github.com/gabotechs/dep-tree/internal/kotlin/kotlin_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	exports := make([]language.ExportEntry, 0)
+
+	var symbols []language.ExportSymbol
+	seen := map[string]struct{}{}
+	for _, stmt := range file.Content.(*kotlin_grammar.File).Statements {
+		decl := stmt.Declaration
+		if decl == nil || decl.Private() {
+			continue
+		}
+		if _, ok := seen[decl.Name()]; ok {
+			continue
+		}
+		seen[decl.Name()] = struct{}{}
+		symbols = append(symbols, language.ExportSymbol{Original: decl.Name()})
+	}
+	if len(symbols) > 0 {
+		exports = append(exports, language.ExportEntry{
+			Symbols: symbols,
+			AbsPath: file.AbsPath,
+		})
+	}
+
+	return &language.ExportsResult{
+		Exports: exports,
+	}, nil
+}
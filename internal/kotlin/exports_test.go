@@ -0,0 +1,56 @@
+package kotlin
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func TestLanguage_ParseExports(t *testing.T) {
+	dataFolder, _ := filepath.Abs(filepath.Join(testFolder, "core", "data", "src", "main", "kotlin", "com", "example", "data"))
+
+	tests := []struct {
+		Name     string
+		Expected []language.ExportEntry
+	}{
+		{
+			Name: "User.kt",
+			Expected: []language.ExportEntry{{
+				Symbols: []language.ExportSymbol{{Original: "User"}, {Original: "Secret"}},
+				AbsPath: filepath.Join(dataFolder, "User.kt"),
+			}},
+		},
+		{
+			Name: "Repository.kt",
+			Expected: []language.ExportEntry{{
+				Symbols: []language.ExportSymbol{{Original: "Repository"}},
+				AbsPath: filepath.Join(dataFolder, "Repository.kt"),
+			}},
+		},
+		{
+			Name: "Format.kt",
+			Expected: []language.ExportEntry{{
+				Symbols: []language.ExportSymbol{{Original: "format"}},
+				AbsPath: filepath.Join(dataFolder, "Format.kt"),
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeKotlinLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(filepath.Join(dataFolder, tt.Name))
+			a.NoError(err)
+
+			exports, err := lang.ParseExports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, exports.Exports)
+		})
+	}
+}
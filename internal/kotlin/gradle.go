@@ -0,0 +1,97 @@
+package kotlin
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var findGradleSettings = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	"settings.gradle",
+	"settings.gradle.kts",
+})
+
+var findGradleBuild = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	"build.gradle",
+	"build.gradle.kts",
+})
+
+// GradleModule is a project included in a Gradle build, like `include(":core:data")`.
+type GradleModule struct {
+	Name   string
+	AbsDir string
+}
+
+type GradleSettings struct {
+	RootProjectName string
+	AbsDir          string
+	Modules         []GradleModule
+}
+
+var (
+	includeRegex     = regexp.MustCompile(`(?m)^\s*include\s*\(?((?:\s*["'][^"'\n]+["']\s*,?)+)\)?`)
+	quotedRegex      = regexp.MustCompile(`["']([^"'\n]+)["']`)
+	projectDirRegex  = regexp.MustCompile(`project\(\s*["']([^"'\n]+)["']\s*\)\.projectDir\s*=\s*(?:file\(\s*|new\s+File\(\s*\w+\s*,\s*)["']([^"'\n]+)["']`)
+	rootProjectRegex = regexp.MustCompile(`rootProject\.name\s*=\s*["']([^"'\n]+)["']`)
+)
+
+// moduleDir returns the default directory of a Gradle project path, which is
+// the path with colons replaced by separators, e.g. ":core:data" -> core/data.
+func moduleDir(root string, name string) string {
+	parts := strings.Split(strings.Trim(name, ":"), ":")
+	return filepath.Join(append([]string{root}, parts...)...)
+}
+
+func _readGradleSettings(dir string) (*GradleSettings, error) {
+	var content []byte
+	var err error
+	for _, name := range []string{"settings.gradle.kts", "settings.gradle"} {
+		content, err = os.ReadFile(filepath.Join(dir, name))
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	settings := GradleSettings{AbsDir: dir}
+	if m := rootProjectRegex.FindSubmatch(content); m != nil {
+		settings.RootProjectName = string(m[1])
+	}
+	projectDirs := map[string]string{}
+	for _, m := range projectDirRegex.FindAllSubmatch(content, -1) {
+		projectDirs[":"+strings.TrimPrefix(string(m[1]), ":")] = filepath.Join(dir, string(m[2]))
+	}
+	for _, include := range includeRegex.FindAllSubmatch(content, -1) {
+		for _, m := range quotedRegex.FindAllSubmatch(include[1], -1) {
+			name := ":" + strings.TrimPrefix(string(m[1]), ":")
+			absDir, ok := projectDirs[name]
+			if !ok {
+				absDir = moduleDir(dir, name)
+			}
+			settings.Modules = append(settings.Modules, GradleModule{Name: name, AbsDir: absDir})
+		}
+	}
+	return &settings, nil
+}
+
+var readGradleSettings = utils.Cached1In1OutErr(_readGradleSettings)
+
+// Module returns the module to which the provided file belongs, which is the
+// included module with the most specific directory containing the file.
+func (s *GradleSettings) Module(absPath string) *GradleModule {
+	var result *GradleModule
+	for i, module := range s.Modules {
+		if !strings.HasPrefix(absPath, module.AbsDir+string(os.PathSeparator)) {
+			continue
+		}
+		if result == nil || len(module.AbsDir) > len(result.AbsDir) {
+			result = &s.Modules[i]
+		}
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+package kotlin
+
+import (
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/kotlin/kotlin_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+// lookup returns the files that declare a symbol in a package, as seen from the
+// provided file.
+func lookup(packages map[string]*Package, pkg string, symbol string, absPath string) []string {
+	p, ok := packages[pkg]
+	if !ok {
+		return nil
+	}
+	var result []string
+	for _, declared := range p.SymbolToFile[symbol] {
+		if declared.AbsPath == absPath {
+			continue
+		}
+		if declared.Internal && declared.Module != moduleOf(absPath) {
+			continue
+		}
+		result = append(result, declared.AbsPath)
+	}
+	return result
+}
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	imports := make([]language.ImportEntry, 0)
+
+	content := file.Content.(*kotlin_grammar.File)
+	thisPackage := ""
+	if pkg := content.Package(); pkg != nil {
+		thisPackage = pkg.String()
+	}
+	packages, err := packagesInRoot(indexRoot(file.AbsPath, content.Package()))
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Explicit imports. They might reference a top level declaration, like
+	//    `import com.example.Foo`, or a member of it, like `import com.example.Foo.bar`,
+	//    so try to find the longest package that declares the next path segment.
+	//    Imported names shadow the ones declared in the current package.
+	explicit := map[string]struct{}{}
+	wildcardPackages := []string{}
+	for _, stmt := range content.Statements {
+		imp := stmt.Import
+		if imp == nil {
+			continue
+		}
+		if imp.All {
+			if _, ok := packages[strings.Join(imp.Path, ".")]; ok {
+				wildcardPackages = append(wildcardPackages, strings.Join(imp.Path, "."))
+				continue
+			}
+		}
+		if imp.Alias != "" {
+			explicit[imp.Alias] = struct{}{}
+		} else if !imp.All {
+			explicit[imp.Path[len(imp.Path)-1]] = struct{}{}
+		}
+		for i := len(imp.Path) - 1; i > 0; i-- {
+			pkg, symbol := strings.Join(imp.Path[:i], "."), imp.Path[i]
+			if absPaths := lookup(packages, pkg, symbol, file.AbsPath); len(absPaths) > 0 {
+				for _, absPath := range absPaths {
+					entry := language.SymbolsImport([]string{symbol}, absPath)
+					entry.Line, entry.Column = imp.Pos.Line, imp.Pos.Column
+					imports = append(imports, entry)
+				}
+				break
+			}
+		}
+	}
+
+	// 2. Declarations from the same package and from wildcard imported packages are
+	//    referenced without naming them in the import statements, so match the
+	//    identifiers used in the file against the symbol index of those packages.
+	pkgLookup := append([]string{thisPackage}, wildcardPackages...)
+	for _, ident := range content.Idents {
+		if _, ok := explicit[ident]; ok {
+			continue
+		}
+		for _, pkg := range pkgLookup {
+			if absPaths := lookup(packages, pkg, ident, file.AbsPath); len(absPaths) > 0 {
+				for _, absPath := range absPaths {
+					imports = append(imports, language.SymbolsImport([]string{ident}, absPath))
+				}
+				break
+			}
+		}
+	}
+
+	return &language.ImportsResult{Imports: imports}, nil
+}
@@ -0,0 +1,71 @@
+package kotlin
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".kotlin_test"
+
+func TestLanguage_ParseImports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+	appFolder := filepath.Join(absTestFolder, "app", "src", "main", "kotlin")
+	dataFolder := filepath.Join(absTestFolder, "core", "data", "src", "main", "kotlin", "com", "example", "data")
+
+	tests := []struct {
+		Name     string
+		File     string
+		Expected []language.ImportEntry
+	}{
+		{
+			Name: "Main.kt",
+			File: filepath.Join(appFolder, "com", "example", "app", "Main.kt"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"Repository"}, filepath.Join(dataFolder, "Repository.kt")), 3, 1),
+				at(language.SymbolsImport([]string{"format"}, filepath.Join(dataFolder, "Format.kt")), 4, 1),
+				language.SymbolsImport([]string{"helper"}, filepath.Join(appFolder, "com", "example", "app", "Helpers.kt")),
+				language.SymbolsImport([]string{"Extra"}, filepath.Join(appFolder, "flat", "Extra.kt")),
+				language.SymbolsImport([]string{"Widget"}, filepath.Join(absTestFolder, "ui", "src", "main", "kotlin", "com", "example", "ui", "Widget.kt")),
+			},
+		},
+		{
+			Name: "Repository.kt",
+			File: filepath.Join(dataFolder, "Repository.kt"),
+			Expected: []language.ImportEntry{
+				language.SymbolsImport([]string{"User"}, filepath.Join(dataFolder, "User.kt")),
+			},
+		},
+		{
+			Name: "Widget.kt",
+			File: filepath.Join(absTestFolder, "ui", "src", "main", "kotlin", "com", "example", "ui", "Widget.kt"),
+			Expected: []language.ImportEntry{
+				language.SymbolsImport([]string{"User"}, filepath.Join(dataFolder, "User.kt")),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeKotlinLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			imports, err := lang.ParseImports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, imports.Imports)
+			a.Nil(imports.Errors)
+		})
+	}
+}
+
+func at(entry language.ImportEntry, line, column int) language.ImportEntry {
+	entry.Line, entry.Column = line, column
+	return entry
+}
@@ -0,0 +1,136 @@
+package kotlin
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/kotlin/kotlin_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+type declaredSymbol struct {
+	AbsPath string
+	// Module is the directory of the module that declares the symbol, symbols
+	// declared as internal are only visible from the same module.
+	Module   string
+	Internal bool
+}
+
+// Package holds the top level declarations of all the files that share a package,
+// no matter in which directory they are placed.
+type Package struct {
+	Name         string
+	SymbolToFile map[string][]declaredSymbol
+}
+
+// packageRoot infers the source root of a file based on its package declaration,
+// e.g. /project/src/main/kotlin/com/example/App.kt declaring `package com.example`
+// has /project/src/main/kotlin as its source root.
+func packageRoot(absPath string, pkg *kotlin_grammar.Package) string {
+	dir := filepath.Dir(absPath)
+	if pkg == nil {
+		return dir
+	}
+	pkgDir := filepath.Join(pkg.Path...)
+	if strings.HasSuffix(dir, string(os.PathSeparator)+pkgDir) {
+		return strings.TrimSuffix(dir, string(os.PathSeparator)+pkgDir)
+	}
+	return dir
+}
+
+// indexRoot returns the directory whose Kotlin files are indexed for resolving
+// the symbols referenced in the provided file: the whole Gradle build if there
+// is one, or the source root of the file otherwise.
+func indexRoot(absPath string, pkg *kotlin_grammar.Package) string {
+	if settings := findGradleSettings(filepath.Dir(absPath)); settings != nil {
+		return settings.AbsDir
+	}
+	if build := findGradleBuild(filepath.Dir(absPath)); build != nil {
+		return build.AbsDir
+	}
+	return packageRoot(absPath, pkg)
+}
+
+// moduleOf returns the directory of the module a file belongs to.
+func moduleOf(absPath string) string {
+	if settings := findGradleSettings(filepath.Dir(absPath)); settings != nil {
+		if s, err := readGradleSettings(settings.AbsDir); err == nil {
+			if module := s.Module(absPath); module != nil {
+				return module.AbsDir
+			}
+		}
+		return settings.AbsDir
+	}
+	return ""
+}
+
+// sourceFiles returns all the Kotlin source files under a dir, skipping
+// build outputs and hidden dirs. Scripts are not indexed, as their
+// declarations cannot be imported.
+func sourceFiles(root string) ([]string, error) {
+	var result []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && (d.Name() == "build" || strings.HasPrefix(d.Name(), ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(d.Name(), ".kt") {
+			result = append(result, path)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// _packagesInRoot builds a symbol index for every package declared by the Kotlin
+// files under a root dir, the same way golang.PackagesInDir does for Go packages.
+func _packagesInRoot(root string) (map[string]*Package, error) {
+	files, err := sourceFiles(root)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]*Package{}
+	for _, absPath := range files {
+		file, err := parseKotlinFile(absPath)
+		if err != nil {
+			// A broken file in the build should not prevent resolving the rest.
+			continue
+		}
+		content := file.Content.(*kotlin_grammar.File)
+		name := ""
+		if pkg := content.Package(); pkg != nil {
+			name = pkg.String()
+		}
+		pkg, ok := result[name]
+		if !ok {
+			pkg = &Package{Name: name, SymbolToFile: map[string][]declaredSymbol{}}
+			result[name] = pkg
+		}
+		module := moduleOf(absPath)
+		for _, stmt := range content.Statements {
+			decl := stmt.Declaration
+			if decl == nil || decl.Private() {
+				continue
+			}
+			symbols := pkg.SymbolToFile[decl.Name()]
+			if len(symbols) > 0 && symbols[len(symbols)-1].AbsPath == absPath {
+				continue
+			}
+			pkg.SymbolToFile[decl.Name()] = append(symbols, declaredSymbol{
+				AbsPath:  absPath,
+				Module:   module,
+				Internal: decl.Internal(),
+			})
+		}
+	}
+	return result, nil
+}
+
+var packagesInRoot, evictPackagesInRoot = utils.EvictableCached1In1OutErr(_packagesInRoot)
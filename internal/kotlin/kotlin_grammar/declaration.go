@@ -0,0 +1,47 @@
+//nolint:govet
+package kotlin_grammar
+
+import "github.com/gabotechs/dep-tree/internal/utils"
+
+type Declaration struct {
+	Modifiers []string  `@("public" | "private" | "internal" | "protected" | "open" | "final" | "abstract" | "sealed" | "data" | "enum" | "annotation" | "inner" | "value" | "inline" | "external" | "expect" | "actual" | "const" | "lateinit" | "suspend" | "tailrec" | "operator" | "infix" | "override")*`
+	Kind      string    `(@("class" | "interface" | "object" | "typealias" | "val" | "var") | @"fun" "interface"?)`
+	Generics  *Generics `@@?`
+	// Segments holds the receiver of extension functions and properties
+	// followed by the declared name, like String.capitalize.
+	Segments []*Segment `@@ ("." @@)*`
+}
+
+type Segment struct {
+	Name     string    `@Ident`
+	Generics *Generics `@@? "?"?`
+}
+
+type Generics struct {
+	Inner []*Generics `"<" (@@ | ANY | Punct | Paren | Ident | String)* ">"`
+}
+
+// Name returns the declared name, without the receiver.
+func (d *Declaration) Name() string {
+	return d.Segments[len(d.Segments)-1].Name
+}
+
+// Type tells if the declaration declares a type, like a class, an interface or an object.
+func (d *Declaration) Type() bool {
+	return d.Kind == "class" || d.Kind == "interface" || d.Kind == "object"
+}
+
+// Abstract tells if the declared type cannot be instantiated, like interfaces or abstract classes.
+func (d *Declaration) Abstract() bool {
+	return d.Kind == "interface" || utils.InArray("abstract", d.Modifiers) || utils.InArray("sealed", d.Modifiers)
+}
+
+// Private declarations are only visible from the file that declares them.
+func (d *Declaration) Private() bool {
+	return utils.InArray("private", d.Modifiers)
+}
+
+// Internal declarations are only visible from the same module.
+func (d *Declaration) Internal() bool {
+	return utils.InArray("internal", d.Modifiers)
+}
@@ -0,0 +1,138 @@
+//nolint:govet
+package kotlin_grammar
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+type Statement struct {
+	Package     *Package     `  @@`
+	Import      *Import      `| @@`
+	Declaration *Declaration `| @@`
+	// Block and Parens swallow anything between braces and parenthesis, so that
+	// only top level declarations are matched as statements.
+	Block  *Block  `| @@`
+	Parens *Parens `| @@`
+}
+
+type Block struct {
+	Blocks []*Block `"{" (@@ | ANY | Punct | Angle | Paren | Ident | String)* "}"`
+}
+
+type Parens struct {
+	Parens []*Parens `"(" (@@ | ANY | Punct | Angle | Brace | Ident | String)* ")"`
+}
+
+type File struct {
+	Statements []*Statement `(@@ | ANY | Punct | Angle | Paren | Brace | Ident | String)*`
+	// Idents are the identifiers referenced in the file, in order of appearance
+	// and without duplicates. Kotlin resolves declarations from the same package
+	// or from wildcard imports without naming the file, so these are used for
+	// matching them against the declarations of other files.
+	Idents []string
+}
+
+var (
+	lex = lexer.MustSimple(
+		[]lexer.SimpleRule{
+			{"Comment", `//.*|/\*(.|\n)*?\*/`},
+			{"Brace", `[{}]`},
+			{"Paren", `[()]`},
+			{"Punct", `->|[.;,@:=?!*+\-/%&|^~\[\]]`},
+			{"Angle", `[<>]`},
+			{"Ident", "[_a-zA-Z][_a-zA-Z0-9]*|`[^`\n]+`"},
+			{"String", `"""(.|\n)*?"""` + "|" + `"(?:\\.|[^"\\])*"` + "|" + `'(?:\\.|[^'\\])*'`},
+			{"Whitespace", `\s+`},
+			{"ANY", `.`},
+		},
+	)
+	parser = participle.MustBuild[File](
+		participle.Lexer(lex),
+		participle.Elide("Whitespace", "Comment"),
+		participle.Map(unquoteIdent, "Ident"),
+		participle.UseLookahead(1024),
+	)
+)
+
+// unquoteIdent removes the backticks from escaped identifiers, like `fun`.
+func unquoteIdent(token lexer.Token) (lexer.Token, error) {
+	if len(token.Value) > 1 && token.Value[0] == '`' {
+		token.Value = token.Value[1 : len(token.Value)-1]
+	}
+	return token, nil
+}
+
+// Package returns the package declared in the file, or nil if the file
+// belongs to the default package.
+func (f *File) Package() *Package {
+	for _, stmt := range f.Statements {
+		if stmt.Package != nil {
+			return stmt.Package
+		}
+	}
+	return nil
+}
+
+// keywords are the hard keywords of the language, which can never reference
+// a declaration.
+var keywords = map[string]struct{}{
+	"as": {}, "break": {}, "class": {}, "continue": {}, "do": {}, "else": {},
+	"false": {}, "for": {}, "fun": {}, "if": {}, "in": {}, "interface": {},
+	"is": {}, "null": {}, "object": {}, "package": {}, "return": {}, "super": {},
+	"this": {}, "throw": {}, "true": {}, "try": {}, "typealias": {}, "typeof": {},
+	"val": {}, "var": {}, "when": {}, "while": {}, "import": {},
+}
+
+func collectIdents(filePath string, content []byte) ([]string, error) {
+	l, err := lex.Lex(filePath, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := lexer.ConsumeAll(l)
+	if err != nil {
+		return nil, err
+	}
+	identType := lex.Symbols()["Ident"]
+	seen := map[string]struct{}{}
+	var idents []string
+	for _, token := range tokens {
+		if token.Type != identType {
+			continue
+		}
+		token, _ = unquoteIdent(token)
+		if _, ok := keywords[token.Value]; ok {
+			continue
+		}
+		if _, ok := seen[token.Value]; !ok {
+			seen[token.Value] = struct{}{}
+			idents = append(idents, token.Value)
+		}
+	}
+	return idents, nil
+}
+
+func Parse(filePath string) (*language.FileInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseBytes(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	file.Idents, err = collectIdents(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	return &language.FileInfo{
+		Content: file,
+		Loc:     bytes.Count(content, []byte("\n")),
+		Size:    len(content),
+		AbsPath: filePath,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package kotlin_grammar
+
+import (
+	"testing"
+
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		Name                 string
+		ExpectedPackage      string
+		ExpectedImports      []Import
+		ExpectedDeclarations []string
+		ExpectedIdents       []string
+	}{
+		{
+			Name:            "package com.example",
+			ExpectedPackage: "com.example",
+			ExpectedIdents:  []string{"com", "example"},
+		},
+		{
+			Name:            "import com.example.Foo\nimport com.example.bar",
+			ExpectedImports: []Import{{Path: []string{"com", "example", "Foo"}}, {Path: []string{"com", "example", "bar"}}},
+			ExpectedIdents:  []string{"com", "example", "Foo", "bar"},
+		},
+		{
+			Name:            "import com.example.*",
+			ExpectedImports: []Import{{Path: []string{"com", "example"}, All: true}},
+			ExpectedIdents:  []string{"com", "example"},
+		},
+		{
+			Name:            "import com.example.Foo as Bar;",
+			ExpectedImports: []Import{{Path: []string{"com", "example", "Foo"}, Alias: "Bar"}},
+			ExpectedIdents:  []string{"com", "example", "Foo", "Bar"},
+		},
+		{
+			Name:                 "data class Foo(val a: Int, var b: String) : Bar()",
+			ExpectedDeclarations: []string{"class Foo"},
+			ExpectedIdents:       []string{"data", "Foo", "a", "Int", "b", "String", "Bar"},
+		},
+		{
+			Name:                 "private fun <T> List<T>.second(): T? = this[1]\ninternal val String.size get() = length",
+			ExpectedDeclarations: []string{"private fun second", "internal val size"},
+			ExpectedIdents:       []string{"private", "T", "List", "second", "internal", "String", "size", "get", "length"},
+		},
+		{
+			Name:                 "@Serializable\nsealed interface Foo { class Inner : Foo; fun bar() {} }\nobject Baz\nfun interface Qux { fun run() }",
+			ExpectedDeclarations: []string{"interface Foo", "object Baz", "fun Qux"},
+			ExpectedIdents:       []string{"Serializable", "sealed", "Foo", "Inner", "bar", "Baz", "Qux", "run"},
+		},
+		{
+			Name:                 "typealias Handler = (Int) -> Unit\nconst val MAX = 1\nfun `weird name`() {}",
+			ExpectedDeclarations: []string{"typealias Handler", "val MAX", "fun weird name"},
+			ExpectedIdents:       []string{"Handler", "Int", "Unit", "const", "MAX", "weird name"},
+		},
+		{
+			Name:                 "// class Foo\n/* class Bar */ val s = \"class Baz ${x}\"",
+			ExpectedIdents:       []string{"s"},
+			ExpectedDeclarations: []string{"val s"},
+		},
+		{
+			Name:                 "package a.b\n\nimport a.c.D\n\nclass E { val d = D(\"{\") }\nfun f() = if (a < b) 1 else 2",
+			ExpectedPackage:      "a.b",
+			ExpectedImports:      []Import{{Path: []string{"a", "c", "D"}}},
+			ExpectedDeclarations: []string{"class E", "fun f"},
+			ExpectedIdents:       []string{"a", "b", "c", "D", "E", "d", "f"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			parsed, err := parser.ParseBytes("", []byte(tt.Name))
+			a.NoError(err)
+			idents, err := collectIdents("", []byte(tt.Name))
+			a.NoError(err)
+
+			var pkg string
+			if p := parsed.Package(); p != nil {
+				pkg = p.String()
+			}
+			var imports []Import
+			var declarations []string
+			for _, stmt := range parsed.Statements {
+				switch {
+				case stmt.Import != nil:
+					imported := *stmt.Import
+					imported.Pos = lexer.Position{}
+					imports = append(imports, imported)
+				case stmt.Declaration != nil:
+					name := stmt.Declaration.Kind + " " + stmt.Declaration.Name()
+					if stmt.Declaration.Private() {
+						name = "private " + name
+					} else if stmt.Declaration.Internal() {
+						name = "internal " + name
+					}
+					declarations = append(declarations, name)
+				}
+			}
+			a.Equal(tt.ExpectedPackage, pkg)
+			a.Equal(tt.ExpectedImports, imports)
+			a.Equal(tt.ExpectedDeclarations, declarations)
+			a.Equal(tt.ExpectedIdents, idents)
+		})
+	}
+}
+
+func TestDeclaration_Abstract(t *testing.T) {
+	a := require.New(t)
+	parsed, err := parser.ParseBytes("", []byte("interface A\nabstract class B\nsealed class C\ndata class D(val d: Int)\nobject E\nfun f() {}\nval g = 1"))
+	a.NoError(err)
+	var types, abstract []string
+	for _, stmt := range parsed.Statements {
+		if stmt.Declaration != nil && stmt.Declaration.Type() {
+			types = append(types, stmt.Declaration.Name())
+			if stmt.Declaration.Abstract() {
+				abstract = append(abstract, stmt.Declaration.Name())
+			}
+		}
+	}
+	a.Equal([]string{"A", "B", "C", "D", "E"}, types)
+	a.Equal([]string{"A", "B", "C"}, abstract)
+}
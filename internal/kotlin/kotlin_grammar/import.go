@@ -0,0 +1,11 @@
+//nolint:govet
+package kotlin_grammar
+
+import "github.com/alecthomas/participle/v2/lexer"
+
+type Import struct {
+	Pos   lexer.Position
+	Path  []string `"import" @Ident ("." @Ident)*`
+	All   bool     `( "." @"*"`
+	Alias string   `| "as" @Ident )? ";"?`
+}
@@ -0,0 +1,80 @@
+package kotlin
+
+import (
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/kotlin/kotlin_grammar"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var Extensions = []string{
+	"kt",
+	"kts",
+}
+
+type Language struct {
+	cfg *Config
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+
+func MakeKotlinLanguage(cfg *Config) (language.Language, error) {
+	lang := Language{
+		cfg: cfg,
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+	return &lang, nil
+}
+
+var parseKotlinFile, evictKotlinFile = utils.EvictableCached1In1OutErr(kotlin_grammar.Parse)
+
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	parsed, err := parseKotlinFile(id)
+	if err != nil {
+		return nil, err
+	}
+	file := *parsed
+	content := file.Content.(*kotlin_grammar.File)
+	if pkg := content.Package(); pkg != nil {
+		file.Package = pkg.String()
+	}
+	if root := findGradleSettings(filepath.Dir(id)); root != nil {
+		file.RelPath, _ = filepath.Rel(root.AbsDir, id)
+		if settings, err := readGradleSettings(root.AbsDir); err == nil {
+			if module := settings.Module(id); module != nil {
+				file.Package = module.Name
+			} else if settings.RootProjectName != "" {
+				file.Package = settings.RootProjectName
+			}
+		}
+	} else {
+		file.RelPath, _ = filepath.Rel(indexRoot(id, content.Package()), id)
+	}
+	file.Types, file.AbstractTypes = countTypes(content)
+	return &file, nil
+}
+
+// Invalidate drops the provided files and the package index of the roots containing them.
+func (l *Language) Invalidate(absPaths ...string) {
+	evictKotlinFile(utils.IsAnyOf(absPaths))
+	evictPackagesInRoot(utils.ContainsAnyOf(absPaths))
+}
+
+// countTypes returns how many types are declared at the top level of the file, and how
+// many of them are interfaces or abstract classes.
+func countTypes(file *kotlin_grammar.File) (int, int) {
+	types, abstractTypes := 0, 0
+	for _, stmt := range file.Statements {
+		if stmt.Declaration != nil && stmt.Declaration.Type() {
+			types++
+			if stmt.Declaration.Abstract() {
+				abstractTypes++
+			}
+		}
+	}
+	return types, abstractTypes
+}
@@ -0,0 +1,63 @@
+package kotlin
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguage_ParseFile(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Path            string
+		ExpectedRelPath string
+		ExpectedPackage string
+		ExpectedTypes   int
+	}{
+		{
+			Name:            "app module",
+			Path:            filepath.Join(testFolder, "app", "src", "main", "kotlin", "com", "example", "app", "Main.kt"),
+			ExpectedRelPath: "app/src/main/kotlin/com/example/app/Main.kt",
+			ExpectedPackage: ":app",
+		},
+		{
+			Name:            "nested module",
+			Path:            filepath.Join(testFolder, "core", "data", "src", "main", "kotlin", "com", "example", "data", "User.kt"),
+			ExpectedRelPath: "core/data/src/main/kotlin/com/example/data/User.kt",
+			ExpectedPackage: ":core:data",
+			ExpectedTypes:   2,
+		},
+		{
+			Name:            "module with custom project dir",
+			Path:            filepath.Join(testFolder, "ui", "src", "main", "kotlin", "com", "example", "ui", "Widget.kt"),
+			ExpectedRelPath: "ui/src/main/kotlin/com/example/ui/Widget.kt",
+			ExpectedPackage: ":core:ui",
+			ExpectedTypes:   1,
+		},
+		{
+			Name:            "root project",
+			Path:            filepath.Join(testFolder, "settings.gradle.kts"),
+			ExpectedRelPath: "settings.gradle.kts",
+			ExpectedPackage: "sample",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeKotlinLanguage(nil)
+			a.NoError(err)
+			absPath, _ := filepath.Abs(tt.Path)
+			file, err := lang.ParseFile(absPath)
+			a.NoError(err)
+			a.Equal(tt.ExpectedPackage, file.Package)
+			a.Equal(tt.ExpectedRelPath, file.RelPath)
+			a.Equal(tt.ExpectedTypes, file.Types)
+			// parsing the same file again must not count its types twice.
+			file, err = lang.ParseFile(absPath)
+			a.NoError(err)
+			a.Equal(tt.ExpectedTypes, file.Types)
+		})
+	}
+}
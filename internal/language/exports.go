@@ -47,7 +47,10 @@ func (p *Parser) parseExports(
 	}
 	defer stack.Pop()
 	cacheKey := fmt.Sprintf("%s-%t", id, unwrappedExports)
-	if cached, ok := p.ExportsCache[cacheKey]; ok {
+	p.mu.RLock()
+	cached, ok := p.ExportsCache[cacheKey]
+	p.mu.RUnlock()
+	if ok {
 		return cached, nil
 	}
 
@@ -106,6 +109,11 @@ func (p *Parser) parseExports(
 	}
 
 	result := ExportEntries{Symbols: exports, Errors: exportErrors}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if cached, ok = p.ExportsCache[cacheKey]; ok {
+		return cached, nil
+	}
 	p.ExportsCache[cacheKey] = &result
 	return &result, nil
 }
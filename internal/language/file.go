@@ -1,13 +1,22 @@
 package language
 
 func (p *Parser) parseFile(absPath string) (*FileInfo, error) {
-	if cached, ok := p.FileCache[absPath]; ok {
+	p.mu.RLock()
+	cached, ok := p.FileCache[absPath]
+	p.mu.RUnlock()
+	if ok {
 		return cached, nil
 	}
 	result, err := p.Lang.ParseFile(absPath)
 	if err != nil {
 		return nil, err
 	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// another goroutine might have parsed the same file in the meantime.
+	if cached, ok = p.FileCache[absPath]; ok {
+		return cached, nil
+	}
 	p.FileCache[absPath] = result
 	return result, err
 }
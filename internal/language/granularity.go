@@ -0,0 +1,126 @@
+package language
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/graph"
+)
+
+const (
+	FileLevel    = "file"
+	DirLevel     = "dir"
+	PackageLevel = "package"
+)
+
+// Granularity tells whether the dependencies are shown between files, or between the
+// directories or packages where files are located.
+type Granularity struct {
+	// Level is one of FileLevel, DirLevel or PackageLevel.
+	Level string
+	// Depth limits how many directories deep, starting from the root of the project, files
+	// are grouped when Level is DirLevel. 0 means that there is no limit.
+	Depth int
+}
+
+func (gr Granularity) Validate() error {
+	switch gr.Level {
+	case FileLevel, DirLevel, PackageLevel:
+	default:
+		return fmt.Errorf("unknown granularity '%s', it must be one of %s, %s or %s", gr.Level, FileLevel, DirLevel, PackageLevel)
+	}
+	if gr.Depth < 0 {
+		return fmt.Errorf("the directory depth must be a positive number, but it is %d", gr.Depth)
+	}
+	return nil
+}
+
+// Group returns the id of the node where the file is collapsed into. Files that do not
+// belong to any package are collapsed into their directory when Level is PackageLevel.
+func (gr Granularity) Group(node *graph.Node[*FileInfo]) string {
+	switch {
+	case gr.Level == FileLevel:
+		return node.Id
+	case gr.Level == PackageLevel && packageId(node.Data) != "":
+		return packageId(node.Data)
+	default:
+		absDir, _ := gr.dir(node.Data)
+		return absDir
+	}
+}
+
+// Collapse returns a graph where files are collapsed into their directories or packages,
+// with edges weighted by the amount of dependencies between files that they stand for.
+// The graph is not modified, and it's returned as is if Level is FileLevel.
+func (gr Granularity) Collapse(g *graph.Graph[*FileInfo]) (*graph.Graph[*FileInfo], error) {
+	if gr.Level == FileLevel {
+		return g, nil
+	}
+	return g.Collapse(gr.Group, gr.merge)
+}
+
+func (gr Granularity) merge(id string, nodes []*graph.Node[*FileInfo]) *FileInfo {
+	first := nodes[0].Data
+	info := &FileInfo{
+		AbsPath:  id,
+		Package:  first.Package,
+		Language: first.Language,
+	}
+	_, info.RelPath = gr.dir(first)
+	if id == packageId(first) {
+		info.AbsPath = filepath.Dir(first.AbsPath)
+		info.RelPath = id
+	}
+	for _, node := range nodes {
+		info.Loc += node.Data.Loc
+		info.Size += node.Data.Size
+		info.Types += node.Data.Types
+		info.AbstractTypes += node.Data.AbstractTypes
+		if node.Data.Package != info.Package {
+			info.Package = ""
+		}
+		if node.Data.Language != info.Language {
+			info.Language = ""
+		}
+		// a package is placed in the deepest directory that holds all of its files.
+		for !strings.HasPrefix(node.Data.AbsPath, info.AbsPath+string(os.PathSeparator)) {
+			parent := filepath.Dir(info.AbsPath)
+			if parent == info.AbsPath {
+				break
+			}
+			info.AbsPath = parent
+		}
+	}
+	return info
+}
+
+func packageId(file *FileInfo) string {
+	if file.PackageId != "" {
+		return file.PackageId
+	}
+	return file.Package
+}
+
+// dir returns the absolute and the relative paths of the directory where the file is
+// collapsed into, taking into account the depth limit. If the path of the file relative
+// to the root of the project is not known, the absolute one is used instead.
+func (gr Granularity) dir(file *FileInfo) (string, string) {
+	absDir := filepath.Dir(file.AbsPath)
+	if file.RelPath == "" {
+		return absDir, absDir
+	}
+	relDir := filepath.Dir(file.RelPath)
+	if relDir == "." || gr.Depth == 0 {
+		return absDir, relDir
+	}
+	parts := strings.Split(relDir, string(os.PathSeparator))
+	for i := gr.Depth; i < len(parts); i++ {
+		absDir = filepath.Dir(absDir)
+	}
+	if len(parts) > gr.Depth {
+		relDir = filepath.Join(parts[:gr.Depth]...)
+	}
+	return absDir, relDir
+}
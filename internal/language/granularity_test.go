@@ -0,0 +1,117 @@
+package language
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/graph"
+)
+
+func TestGranularity_Collapse(t *testing.T) {
+	root := filepath.FromSlash("/project")
+	file := func(relPath string, pkg string, loc int) *graph.Node[*FileInfo] {
+		absPath := filepath.Join(root, relPath)
+		return graph.MakeNode(absPath, &FileInfo{AbsPath: absPath, RelPath: relPath, Package: pkg, Loc: loc})
+	}
+	g := graph.NewGraph[*FileInfo]()
+	files := []*graph.Node[*FileInfo]{
+		file("main.go", "main", 1),
+		file(filepath.Join("src", "a", "a.go"), "a", 2),
+		file(filepath.Join("src", "a", "inner", "b.go"), "a", 3),
+		file(filepath.Join("src", "c", "c.go"), "c", 4),
+	}
+	for _, node := range files {
+		require.NoError(t, g.AddNode(node))
+	}
+	require.NoError(t, g.AddFromToEdge(files[0].Id, files[1].Id, files[2].Id, files[3].Id))
+	require.NoError(t, g.AddFromToEdge(files[1].Id, files[2].Id, files[3].Id))
+	require.NoError(t, g.AddFromToEdge(files[2].Id, files[3].Id))
+
+	tests := []struct {
+		Name        string
+		Granularity Granularity
+		Expected    []string
+		Edges       map[[2]string]int
+	}{
+		{
+			Name:        "Files",
+			Granularity: Granularity{Level: FileLevel},
+			Expected:    []string{"main.go", "src/a/a.go", "src/a/inner/b.go", "src/c/c.go"},
+		},
+		{
+			Name:        "Directories",
+			Granularity: Granularity{Level: DirLevel},
+			Expected:    []string{".", "src/a", "src/a/inner", "src/c"},
+			Edges: map[[2]string]int{
+				{".", "src/a"}:           1,
+				{"src/a", "src/a/inner"}: 1,
+				{"src/a", "src/c"}:       1,
+				{"src/a/inner", "src/c"}: 1,
+				{".", "src/a/inner"}:     1,
+				{".", "src/c"}:           1,
+			},
+		},
+		{
+			Name:        "Directories up to depth 1",
+			Granularity: Granularity{Level: DirLevel, Depth: 1},
+			Expected:    []string{".", "src"},
+			Edges:       map[[2]string]int{{".", "src"}: 3},
+		},
+		{
+			Name:        "Packages",
+			Granularity: Granularity{Level: PackageLevel},
+			Expected:    []string{"main", "a", "c"},
+			Edges: map[[2]string]int{
+				{"main", "a"}: 2,
+				{"main", "c"}: 1,
+				{"a", "c"}:    2,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			a.NoError(tt.Granularity.Validate())
+			collapsed, err := tt.Granularity.Collapse(g)
+			a.NoError(err)
+
+			var relPaths []string
+			byRelPath := map[string]*graph.Node[*FileInfo]{}
+			for _, node := range collapsed.AllNodes() {
+				relPaths = append(relPaths, filepath.ToSlash(node.Data.RelPath))
+				byRelPath[filepath.ToSlash(node.Data.RelPath)] = node
+			}
+			a.Equal(tt.Expected, relPaths)
+
+			for edge, weight := range tt.Edges {
+				from, to := byRelPath[edge[0]], byRelPath[edge[1]]
+				a.Equal(weight, collapsed.EdgeData(from.Id, to.Id).Weight, edge)
+			}
+		})
+	}
+
+	t.Run("Merged data", func(t *testing.T) {
+		a := require.New(t)
+		collapsed, err := Granularity{Level: PackageLevel}.Collapse(g)
+		a.NoError(err)
+		pkg := collapsed.Get("a")
+		a.Equal(filepath.Join(root, "src", "a"), pkg.Data.AbsPath)
+		a.Equal("a", pkg.Data.Package)
+		a.Equal(5, pkg.Data.Loc)
+
+		collapsed, err = Granularity{Level: DirLevel, Depth: 1}.Collapse(g)
+		a.NoError(err)
+		dir := collapsed.Get(filepath.Join(root, "src"))
+		a.Equal("", dir.Data.Package)
+		a.Equal(9, dir.Data.Loc)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		a := require.New(t)
+		a.Error(Granularity{Level: "module"}.Validate())
+		a.Error(Granularity{Level: DirLevel, Depth: -1}.Validate())
+	})
+}
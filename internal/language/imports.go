@@ -1,7 +1,12 @@
 package language
 
+import "github.com/gabotechs/dep-tree/internal/graph"
+
 func (p *Parser) gatherImportsFromFile(id string) (*ImportsResult, error) {
-	if cached, ok := p.ImportsCache[id]; ok {
+	p.mu.RLock()
+	cached, ok := p.ImportsCache[id]
+	p.mu.RUnlock()
+	if ok {
 		return cached, nil
 	}
 	file, err := p.parseFile(id)
@@ -12,6 +17,33 @@ func (p *Parser) gatherImportsFromFile(id string) (*ImportsResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if cached, ok = p.ImportsCache[id]; ok {
+		return cached, nil
+	}
 	p.ImportsCache[id] = result
 	return result, err
 }
+
+// ImportWeight tells how many symbols are imported through an edge that ends in the file to.
+// Importing everything counts as importing all the symbols exported by to, and each import
+// counts at least as 1, even if it does not import any symbol.
+func (p *Parser) ImportWeight(to string, data *graph.EdgeData) (int, error) {
+	if data == nil {
+		return 1, nil
+	}
+	weight := 0
+	for _, imp := range data.Imports {
+		symbols := len(imp.Symbols)
+		if imp.All {
+			exports, err := p.parseExports(to, false, nil)
+			if err != nil {
+				return 0, err
+			}
+			symbols = max(symbols, exports.Symbols.Len())
+		}
+		weight += max(symbols, 1)
+	}
+	return max(weight, 1), nil
+}
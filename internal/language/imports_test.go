@@ -4,6 +4,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gabotechs/dep-tree/internal/graph"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,3 +35,44 @@ func TestParser_parseImports_IsCached(t *testing.T) {
 	ratio := nonCached.Nanoseconds() / cached.Nanoseconds()
 	a.Greater(ratio, int64(10))
 }
+
+func TestParser_ImportWeight(t *testing.T) {
+	a := require.New(t)
+	lang := TestLanguage{
+		imports: map[string]*ImportsResult{
+			"1": {
+				Imports: []ImportEntry{
+					{Symbols: []string{"A", "B"}, AbsPath: "2", Line: 1},
+					{AbsPath: "2", Line: 2},
+					{All: true, AbsPath: "3", Line: 3},
+				},
+			},
+		},
+		exports: b().
+			Entry("1", "4", "D").
+			Entry("2", "2", "A", "B").
+			Entry("3", "3", "A", "B", "C").
+			Entry("4", "4", "D").
+			Build(),
+	}
+	parser := lang.testParser()
+
+	node, err := parser.Node("1")
+	a.NoError(err)
+	deps, edges, err := parser.DepsWithEdges(node)
+	a.NoError(err)
+	data := map[string]*graph.EdgeData{}
+	for i, dep := range deps {
+		data[dep.Id] = edges[i]
+	}
+	a.Equal(&graph.EdgeData{Imports: []graph.EdgeImport{
+		{Symbols: []string{"A", "B"}, Line: 1},
+		{Line: 2},
+	}}, data["2"])
+
+	for to, expected := range map[string]int{"2": 3, "3": 3, "4": 1, "5": 1} {
+		weight, err := parser.ImportWeight(to, data[to])
+		a.NoError(err)
+		a.Equal(expected, weight, to)
+	}
+}
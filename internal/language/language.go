@@ -16,10 +16,32 @@ type FileInfo struct {
 	// Package might be the "name" field of the closest package.json file, for rust the name of the
 	// cargo workspace where the file belongs to.
 	Package string
+	// PackageId identifies the package when files are collapsed into packages, for languages
+	// where different packages can have the same name, like Go, where it's the import path.
+	// Package is used instead if it's empty.
+	PackageId string
+	// Language is the name of the language implementation that parsed the source file. It is only
+	// set when files from different languages are part of the same graph.
+	Language string
 	// Loc is the amount of lines of code a file has.
 	Loc int
 	// Size is the size in bytes of the file.
 	Size int
+	// Types is the amount of types, like classes or interfaces, declared in the file. It is 0 if
+	// the language implementation does not tell.
+	Types int
+	// AbstractTypes is how many of the declared Types are abstract, like interfaces or abstract classes.
+	AbstractTypes int
+}
+
+// Abstractness is the ratio of abstract types declared in the file, or nil if the language
+// implementation does not tell.
+func (f *FileInfo) Abstractness() *float64 {
+	if f.Types == 0 {
+		return nil
+	}
+	result := float64(f.AbstractTypes) / float64(f.Types)
+	return &result
 }
 
 // ImportEntry represents an import statement in a programming language.
@@ -41,6 +63,14 @@ type ImportEntry struct {
 	//   import { baz } from './baz'
 	// will result in an ImportEntry with AbsPath = /foo/baz.ts
 	AbsPath string
+	// TypeOnly is true if only types are imported, so the dependency does not exist at runtime.
+	// JS -> import type { Foo } from './foo'
+	TypeOnly bool
+	// Line is the line, starting from 1, where the import statement is located in the source file.
+	// It is 0 if the language implementation does not know where the import statement is.
+	Line int
+	// Column is the column, starting from 1, where the import statement is located in the source file.
+	Column int
 }
 
 // AllImport builds an ImportEntry where all the symbols are imported.
@@ -122,3 +152,9 @@ type Language interface {
 	//  F contains.
 	ParseExports(file *FileInfo) (*ExportsResult, error)
 }
+
+// Invalidator is implemented by languages that keep some state about the files that
+// they parsed, which needs to be dropped if the files change.
+type Invalidator interface {
+	Invalidate(absPaths ...string)
+}
@@ -1,6 +1,8 @@
 package language
 
 import (
+	"sync"
+
 	"github.com/elliotchance/orderedmap/v2"
 	"github.com/gabotechs/dep-tree/internal/graph"
 	"github.com/gabotechs/dep-tree/internal/utils"
@@ -11,10 +13,14 @@ type Parser struct {
 	UnwrapProxyExports bool
 	Exclude            []string
 	Include            []string
+	// Jobs is the amount of files that can be parsed at the same time.
+	Jobs int
 	// cache
 	FileCache    map[string]*FileInfo
 	ImportsCache map[string]*ImportsResult
 	ExportsCache map[string]*ExportEntries
+	// mu guards the caches, as nodes might be parsed concurrently.
+	mu sync.RWMutex
 }
 
 func NewParser(lang Language) *Parser {
@@ -29,8 +35,32 @@ func NewParser(lang Language) *Parser {
 }
 
 var _ graph.NodeParser[*FileInfo] = &Parser{}
+var _ graph.ConcurrentNodeParser = &Parser{}
+var _ graph.EdgesNodeParser[*FileInfo] = &Parser{}
+
+func (p *Parser) Concurrency() int {
+	return p.Jobs
+}
+
+// Invalidate drops everything cached for the provided files, so that they are parsed
+// again. Imports and exports are dropped for all the files, as they might be resolved
+// through the invalidated ones, like a symbol that moved from one file to another.
+func (p *Parser) Invalidate(absPaths ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, absPath := range absPaths {
+		delete(p.FileCache, absPath)
+	}
+	p.ImportsCache = make(map[string]*ImportsResult)
+	p.ExportsCache = make(map[string]*ExportEntries)
+	utils.EvictExists(absPaths...)
+	if invalidator, ok := p.Lang.(Invalidator); ok {
+		invalidator.Invalidate(absPaths...)
+	}
+}
 
-func (p *Parser) shouldExclude(path string) bool {
+// ShouldExclude tells if a file is left out of the graph because of the Exclude or Include patterns.
+func (p *Parser) ShouldExclude(path string) bool {
 	for _, exclusion := range p.Exclude {
 		if ok, _ := utils.GlobstarMatch(exclusion, path); ok {
 			return true
@@ -49,7 +79,7 @@ func (p *Parser) shouldExclude(path string) bool {
 }
 
 func (p *Parser) Node(id string) (*graph.Node[*FileInfo], error) {
-	if p.shouldExclude(id) {
+	if p.ShouldExclude(id) {
 		return nil, nil
 	}
 	file, err := p.parseFile(id)
@@ -60,11 +90,19 @@ func (p *Parser) Node(id string) (*graph.Node[*FileInfo], error) {
 }
 
 func (p *Parser) Deps(n *graph.Node[*FileInfo]) ([]*graph.Node[*FileInfo], error) {
+	deps, _, err := p.DepsWithEdges(n)
+	return deps, err
+}
+
+// DepsWithEdges gathers the dependencies of a file along with the import statements that
+// caused each one of them.
+func (p *Parser) DepsWithEdges(n *graph.Node[*FileInfo]) ([]*graph.Node[*FileInfo], []*graph.EdgeData, error) {
 	imports, err := p.gatherImportsFromFile(n.Id)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	n.AddErrors(imports.Errors...)
+	importEntries := append([]ImportEntry{}, imports.Imports...)
 
 	// Some exports might be re-exporting symbols from other files, we consider
 	// those as if they were normal imports.
@@ -81,62 +119,85 @@ func (p *Parser) Deps(n *graph.Node[*FileInfo]) ([]*graph.Node[*FileInfo], error
 	//  Instead, we never unwrap export to avoid this.
 	exports, err := p.parseExports(n.Id, false, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	n.AddErrors(exports.Errors...)
 	for el := exports.Symbols.Front(); el != nil; el = el.Next() {
 		if el.Value != n.Id {
-			imports.Imports = append(imports.Imports, ImportEntry{
+			importEntries = append(importEntries, ImportEntry{
 				Symbols: []string{el.Key},
 				AbsPath: el.Value,
 			})
 		}
 	}
 
-	resolvedImports := orderedmap.NewOrderedMap[string, bool]()
+	resolvedImports := orderedmap.NewOrderedMap[string, *graph.EdgeData]()
+	addEdgeImport := func(absPath string, entry ImportEntry, symbols []string) {
+		data, ok := resolvedImports.Get(absPath)
+		if !ok {
+			data = &graph.EdgeData{}
+			resolvedImports.Set(absPath, data)
+		}
+		data.Imports = append(data.Imports, graph.EdgeImport{
+			All:      entry.All,
+			Symbols:  symbols,
+			TypeOnly: entry.TypeOnly,
+			Line:     entry.Line,
+			Column:   entry.Column,
+		})
+	}
 
 	// Imported names might not necessarily be declared in the path that is being imported, they might be declared in
 	// a different file, we want that file. Ex: foo.ts -> utils/index.ts -> utils/sum.ts. If unwrapProxyExports is
 	// set to true, we must trace those exports back.
-	for _, importEntry := range imports.Imports {
+	for _, importEntry := range importEntries {
 		if !p.UnwrapProxyExports {
-			resolvedImports.Set(importEntry.AbsPath, true)
+			addEdgeImport(importEntry.AbsPath, importEntry, importEntry.Symbols)
 			continue
 		}
 
 		// NOTE: at this point p.unwrapProxyExports is always true.
 		exports, err = p.parseExports(importEntry.AbsPath, p.UnwrapProxyExports, nil)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		n.AddErrors(exports.Errors...)
+		// symbols imported by this entry grouped by the path where they are declared.
+		unwrapped := orderedmap.NewOrderedMap[string, []string]()
 		if importEntry.All {
 			// If all imported, then dump every path in the resolved imports.
 			for el := exports.Symbols.Front(); el != nil; el = el.Next() {
-				resolvedImports.Set(el.Value, true)
+				symbols, _ := unwrapped.Get(el.Value)
+				unwrapped.Set(el.Value, append(symbols, el.Key))
 			}
 		} else if len(importEntry.Symbols) == 0 {
-			resolvedImports.Set(importEntry.AbsPath, true)
+			unwrapped.Set(importEntry.AbsPath, nil)
 		} else {
 			for _, name := range importEntry.Symbols {
 				if exportPath, ok := exports.Symbols.Get(name); ok {
-					resolvedImports.Set(exportPath, true)
+					symbols, _ := unwrapped.Get(exportPath)
+					unwrapped.Set(exportPath, append(symbols, name))
 				} else {
 					// TODO: this is not retro-compatible, do it in a different PR.
 					// n.AddErrors(fmt.Errorf("name %s is imported by %s but not exported by %s", name, n.Id, importEntry.Id)).
 				}
 			}
 		}
+		for el := unwrapped.Front(); el != nil; el = el.Next() {
+			addEdgeImport(el.Key, importEntry, el.Value)
+		}
 	}
 
 	deps := make([]*graph.Node[*FileInfo], 0)
-	for _, imported := range resolvedImports.Keys() {
-		node, err := p.Node(imported)
+	edges := make([]*graph.EdgeData, 0)
+	for el := resolvedImports.Front(); el != nil; el = el.Next() {
+		node, err := p.Node(el.Key)
 		if err != nil {
 			n.AddErrors(err)
 		} else if node != nil {
 			deps = append(deps, node)
+			edges = append(edges, el.Value)
 		}
 	}
-	return deps, nil
+	return deps, edges, nil
 }
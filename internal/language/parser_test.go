@@ -6,7 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestParser_shouldExclude(t *testing.T) {
+func TestParser_ShouldExclude(t *testing.T) {
 	tests := []struct {
 		Name     string
 		Paths    []string
@@ -64,7 +64,7 @@ func TestParser_shouldExclude(t *testing.T) {
 			parser := Parser{Exclude: tt.Exclude, Include: tt.Include}
 			var result []string
 			for _, path := range tt.Paths {
-				if !parser.shouldExclude(path) {
+				if !parser.ShouldExclude(path) {
 					result = append(result, path)
 				}
 			}
@@ -0,0 +1,38 @@
+package language
+
+import (
+	"github.com/gabotechs/dep-tree/internal/graph"
+)
+
+// UnusedExports returns the symbols that the file declares and exports, but that no other file
+// in the graph imports. Symbols re-exported from other files are not taken into account. If it's
+// not known which symbols an import uses, like with `import foo` in Python or with includes
+// in C, all of them are considered as used.
+func (p *Parser) UnusedExports(g *graph.Graph[*FileInfo], id string) ([]string, error) {
+	exports, err := p.parseExports(id, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	used := map[string]bool{}
+	for _, importer := range g.ToId(id) {
+		data := g.EdgeData(importer.Id, id)
+		if data == nil {
+			return nil, nil
+		}
+		for _, imp := range data.Imports {
+			if imp.All || len(imp.Symbols) == 0 {
+				return nil, nil
+			}
+			for _, symbol := range imp.Symbols {
+				used[symbol] = true
+			}
+		}
+	}
+	var result []string
+	for el := exports.Symbols.Front(); el != nil; el = el.Next() {
+		if el.Value == id && !used[el.Key] {
+			result = append(result, el.Key)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,47 @@
+package language
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/graph"
+)
+
+func TestParser_UnusedExports(t *testing.T) {
+	a := require.New(t)
+	lang := TestLanguage{
+		imports: map[string]*ImportsResult{
+			"1": {
+				Imports: []ImportEntry{
+					SymbolsImport([]string{"A"}, "2"),
+					EmptyImport("4"),
+				},
+			},
+			"2": {},
+			"3": {},
+			"4": {},
+		},
+		exports: b().
+			Entry("1", "1", "X").
+			Entry("2", "2", "A", "B").
+			Entry("2", "3", "C").
+			Entry("3", "3", "C", "D").
+			Entry("4", "4", "E").
+			Build(),
+	}
+	parser := lang.testParser()
+	g := graph.NewGraph[*FileInfo]()
+	a.NoError(g.Load([]string{"1"}, parser, nil))
+
+	for id, expected := range map[string][]string{
+		"1": {"X"},
+		"2": {"B"},
+		"3": {"D"},
+		"4": nil,
+	} {
+		unused, err := parser.UnusedExports(g, id)
+		a.NoError(err)
+		a.Equal(expected, unused, id)
+	}
+}
@@ -0,0 +1,282 @@
+package metrics
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/gammazero/deque"
+	"gonum.org/v1/gonum/graph/network"
+
+	"github.com/gabotechs/dep-tree/internal/graph"
+)
+
+const (
+	pageRankDamping   = 0.85
+	pageRankTolerance = 1e-8
+)
+
+// Metrics are the coupling and centrality metrics of a single node in the graph.
+type Metrics struct {
+	Name string `json:"name"`
+	// Afferent is the amount of nodes that depend on this one (Ca).
+	Afferent int `json:"afferent"`
+	// Efferent is the amount of nodes that this one depends on (Ce).
+	Efferent int `json:"efferent"`
+	// Instability is Ce / (Ca + Ce), 0 means that the node is maximally stable and 1 that
+	// it's maximally unstable.
+	Instability float64 `json:"instability"`
+	// Abstractness is the ratio of abstract types declared in the node, it's nil if the
+	// language does not tell which types are abstract.
+	Abstractness *float64 `json:"abstractness"`
+	// Depth is the length of the shortest path from any of the entrypoints to this node,
+	// or -1 if it's not reachable from them.
+	Depth int `json:"depth"`
+	// Betweenness is the amount of shortest paths between other nodes that go through this one.
+	Betweenness float64 `json:"betweenness"`
+	// PageRank is the importance of this node, taking into account how important are the
+	// nodes that depend on it.
+	PageRank float64 `json:"pagerank"`
+	// Transitive is the amount of nodes that this one depends on, directly or not.
+	Transitive int `json:"transitive"`
+}
+
+// Compute returns the metrics of all the nodes in the graph, in the same order as they were
+// added to it. display is used for naming each node, and abstractness tells the ratio of
+// abstract types declared in a node, or nil if it's not known.
+func Compute[T any](
+	g *graph.Graph[T],
+	entrypoints []string,
+	display func(node *graph.Node[T]) string,
+	abstractness func(node *graph.Node[T]) *float64,
+) []Metrics {
+	nodes := g.AllNodes()
+	result := make([]Metrics, len(nodes))
+	if len(nodes) == 0 {
+		return result
+	}
+
+	betweenness := network.Betweenness(g)
+	pageRank := network.PageRankSparse(g, pageRankDamping, pageRankTolerance)
+	depths := depths(g, entrypoints)
+	transitive := transitiveDeps(g)
+
+	for i, node := range nodes {
+		afferent := len(g.ToId(node.Id))
+		efferent := len(g.FromId(node.Id))
+		instability := 0.0
+		if afferent+efferent > 0 {
+			instability = float64(efferent) / float64(afferent+efferent)
+		}
+		depth, ok := depths[node.Id]
+		if !ok {
+			depth = -1
+		}
+		result[i] = Metrics{
+			Name:         display(node),
+			Afferent:     afferent,
+			Efferent:     efferent,
+			Instability:  instability,
+			Abstractness: abstractness(node),
+			Depth:        depth,
+			Betweenness:  betweenness[node.ID()],
+			PageRank:     pageRank[node.ID()],
+			Transitive:   transitive[node.Id],
+		}
+	}
+	return result
+}
+
+// depths returns the length of the shortest path from any of the entrypoints to each
+// one of the nodes reachable from them.
+func depths[T any](g *graph.Graph[T], entrypoints []string) map[string]int {
+	result := map[string]int{}
+	var queue deque.Deque[string]
+	for _, entrypoint := range entrypoints {
+		if _, ok := result[entrypoint]; !ok && g.Has(entrypoint) {
+			result[entrypoint] = 0
+			queue.PushBack(entrypoint)
+		}
+	}
+	for queue.Len() > 0 {
+		id := queue.PopFront()
+		for _, dep := range g.FromId(id) {
+			if _, ok := result[dep.Id]; !ok {
+				result[dep.Id] = result[id] + 1
+				queue.PushBack(dep.Id)
+			}
+		}
+	}
+	return result
+}
+
+// transitiveDeps returns the amount of nodes that each node depends on, directly or not.
+// All the nodes in a strongly connected component reach the same nodes, so reachability is
+// computed once for each component of the condensation of the graph, which has no cycles,
+// merging the sets of reachable components of its dependencies, which are computed first.
+func transitiveDeps[T any](g *graph.Graph[T]) map[string]int {
+	components := g.StronglyConnectedComponents()
+	componentOf := make(map[string]int, len(g.AllNodes()))
+	for i, component := range components {
+		for _, id := range component {
+			componentOf[id] = i
+		}
+	}
+	children := make([][]int, len(components))
+	parents := make([]int, len(components))
+	for i, component := range components {
+		seen := map[int]bool{i: true}
+		for _, id := range component {
+			for _, dep := range g.FromId(id) {
+				if j := componentOf[dep.Id]; !seen[j] {
+					seen[j] = true
+					children[i] = append(children[i], j)
+					parents[j]++
+				}
+			}
+		}
+	}
+
+	// Kahn's algorithm sorts the components topologically, so traversing them backwards
+	// visits each one after all its dependencies.
+	pending := slices.Clone(parents)
+	var order []int
+	for i := range components {
+		if pending[i] == 0 {
+			order = append(order, i)
+		}
+	}
+	for k := 0; k < len(order); k++ {
+		for _, j := range children[order[k]] {
+			pending[j]--
+			if pending[j] == 0 {
+				order = append(order, j)
+			}
+		}
+	}
+
+	// Most components have a single node, so counting the reachable nodes is counting
+	// the reachable components, plus the extra nodes of the bigger ones.
+	var tangles []int
+	for i, component := range components {
+		if len(component) > 1 {
+			tangles = append(tangles, i)
+		}
+	}
+	words := (len(components) + 63) / 64
+	reach := make([][]uint64, len(components))
+	result := make(map[string]int, len(componentOf))
+	for k := len(order) - 1; k >= 0; k-- {
+		i := order[k]
+		reach[i] = make([]uint64, words)
+		reach[i][i/64] |= 1 << (i % 64)
+		for _, j := range children[i] {
+			for w := range reach[i] {
+				reach[i][w] |= reach[j][w]
+			}
+			// the set of a component is not needed anymore once all the components
+			// that depend on it have merged it.
+			parents[j]--
+			if parents[j] == 0 {
+				reach[j] = nil
+			}
+		}
+		count := -1
+		for _, word := range reach[i] {
+			count += bits.OnesCount64(word)
+		}
+		for _, j := range tangles {
+			if reach[i][j/64]&(1<<(j%64)) != 0 {
+				count += len(components[j]) - 1
+			}
+		}
+		for _, id := range components[i] {
+			result[id] = count
+		}
+	}
+	return result
+}
+
+// Column is one of the metrics that can be displayed and used for ranking nodes.
+type Column struct {
+	Name string
+	// Format renders the value of this metric.
+	Format func(m Metrics) string
+	// less tells if the value of this metric in a is lower than in b.
+	less func(a, b Metrics) bool
+}
+
+func intColumn(name string, value func(m Metrics) int) Column {
+	return Column{
+		Name:   name,
+		Format: func(m Metrics) string { return fmt.Sprintf("%d", value(m)) },
+		less:   func(a, b Metrics) bool { return value(a) < value(b) },
+	}
+}
+
+func floatColumn(name string, value func(m Metrics) float64) Column {
+	return Column{
+		Name:   name,
+		Format: func(m Metrics) string { return fmt.Sprintf("%.3f", value(m)) },
+		less:   func(a, b Metrics) bool { return value(a) < value(b) },
+	}
+}
+
+// Columns are all the metrics, in the order in which they are displayed.
+var Columns = []Column{
+	{
+		Name:   "name",
+		Format: func(m Metrics) string { return m.Name },
+		// names are ranked alphabetically, so they are reversed.
+		less: func(a, b Metrics) bool { return a.Name > b.Name },
+	},
+	intColumn("afferent", func(m Metrics) int { return m.Afferent }),
+	intColumn("efferent", func(m Metrics) int { return m.Efferent }),
+	floatColumn("instability", func(m Metrics) float64 { return m.Instability }),
+	{
+		Name: "abstractness",
+		Format: func(m Metrics) string {
+			if m.Abstractness == nil {
+				return "-"
+			}
+			return fmt.Sprintf("%.3f", *m.Abstractness)
+		},
+		less: func(a, b Metrics) bool {
+			return b.Abstractness != nil && (a.Abstractness == nil || *a.Abstractness < *b.Abstractness)
+		},
+	},
+	intColumn("depth", func(m Metrics) int { return m.Depth }),
+	floatColumn("betweenness", func(m Metrics) float64 { return m.Betweenness }),
+	floatColumn("pagerank", func(m Metrics) float64 { return m.PageRank }),
+	intColumn("transitive", func(m Metrics) int { return m.Transitive }),
+}
+
+func columnNames() []string {
+	names := make([]string, len(Columns))
+	for i, column := range Columns {
+		names[i] = column.Name
+	}
+	return names
+}
+
+// Rank sorts the metrics from the highest to the lowest value of the provided column,
+// breaking ties by name.
+func Rank(metrics []Metrics, by string) error {
+	i := slices.IndexFunc(Columns, func(c Column) bool { return c.Name == by })
+	if i == -1 {
+		return fmt.Errorf("unknown metric '%s', it must be one of %s", by, strings.Join(columnNames(), ", "))
+	}
+	column := Columns[i]
+	slices.SortStableFunc(metrics, func(a, b Metrics) int {
+		switch {
+		case column.less(b, a):
+			return -1
+		case column.less(a, b):
+			return 1
+		default:
+			return strings.Compare(a.Name, b.Name)
+		}
+	})
+	return nil
+}
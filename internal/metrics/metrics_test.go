@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/graph"
+)
+
+func TestCompute(t *testing.T) {
+	a := require.New(t)
+	g := graph.MakeTestGraph([][]int{
+		0: {1, 2},
+		1: {3},
+		2: {3},
+		3: {},
+	})
+	half := 0.5
+
+	result := Compute(g, []string{"0"}, func(node *graph.Node[int]) string {
+		return node.Id
+	}, func(node *graph.Node[int]) *float64 {
+		if node.Id == "3" {
+			return &half
+		}
+		return nil
+	})
+
+	a.Len(result, 4)
+	for i := range result {
+		a.Greater(result[i].PageRank, 0.0)
+		result[i].PageRank = 0
+	}
+	a.Equal([]Metrics{
+		{Name: "0", Afferent: 0, Efferent: 2, Instability: 1, Depth: 0, Transitive: 3},
+		{Name: "1", Afferent: 1, Efferent: 1, Instability: 0.5, Depth: 1, Betweenness: 0.5, Transitive: 1},
+		{Name: "2", Afferent: 1, Efferent: 1, Instability: 0.5, Depth: 1, Betweenness: 0.5, Transitive: 1},
+		{Name: "3", Afferent: 2, Efferent: 0, Instability: 0, Abstractness: &half, Depth: 2, Transitive: 0},
+	}, result)
+}
+
+func TestTransitiveDeps(t *testing.T) {
+	a := require.New(t)
+	g := graph.MakeTestGraph([][]int{
+		0: {1, 3},
+		1: {2},
+		2: {3, 4},
+		3: {1},
+		4: {},
+	})
+
+	a.Equal(map[string]int{"0": 4, "1": 3, "2": 3, "3": 3, "4": 0}, transitiveDeps(g))
+}
+
+func TestRank(t *testing.T) {
+	one, zero := 1.0, 0.0
+	metrics := []Metrics{
+		{Name: "a", Afferent: 1, PageRank: 0.1},
+		{Name: "b", Afferent: 3, PageRank: 0.5, Abstractness: &zero},
+		{Name: "c", Afferent: 1, PageRank: 0.4, Abstractness: &one},
+	}
+
+	tests := []struct {
+		By       string
+		Expected []string
+		Error    string
+	}{
+		{By: "afferent", Expected: []string{"b", "a", "c"}},
+		{By: "pagerank", Expected: []string{"b", "c", "a"}},
+		{By: "abstractness", Expected: []string{"c", "b", "a"}},
+		{By: "name", Expected: []string{"a", "b", "c"}},
+		{By: "loc", Error: "unknown metric 'loc'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.By, func(t *testing.T) {
+			a := require.New(t)
+			ranked := append([]Metrics{}, metrics...)
+			err := Rank(ranked, tt.By)
+			if tt.Error != "" {
+				a.ErrorContains(err, tt.Error)
+				return
+			}
+			a.NoError(err)
+			names := make([]string, len(ranked))
+			for i, m := range ranked {
+				names[i] = m.Name
+			}
+			a.Equal(tt.Expected, names)
+		})
+	}
+}
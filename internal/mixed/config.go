@@ -0,0 +1,18 @@
+package mixed
+
+// Edge declares a dependency between files that no language can infer by
+// itself, like a generated TS client depending on the Go handler it calls.
+type Edge struct {
+	// From is a glob pattern matching the files where the edge starts.
+	From string `yaml:"from"`
+	// To are the files, or glob patterns, that the From files depend on.
+	To []string `yaml:"to"`
+}
+
+type Config struct {
+	// Enabled makes every supported language part of the same graph, instead
+	// of picking one based on the provided entrypoints.
+	Enabled bool `yaml:"enabled"`
+	// Edges are cross-language dependencies that are added to the graph.
+	Edges []Edge `yaml:"edges"`
+}
@@ -0,0 +1,207 @@
+package mixed
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sync"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// Backend is a language that can take part in a mixed graph.
+type Backend struct {
+	// Name identifies the language, it is reported in language.FileInfo.
+	Name string
+	// Extensions are the file extensions handled by the language.
+	Extensions []string
+	// Make builds the language, given the first file that needs it.
+	Make func(file string) (language.Language, error)
+}
+
+type backend struct {
+	Backend
+	mu   sync.Mutex
+	lang language.Language
+	err  error
+}
+
+func (b *backend) get(file string) (language.Language, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.lang == nil && b.err == nil {
+		b.lang, b.err = b.Make(file)
+		if b.err != nil {
+			b.err = fmt.Errorf("could not initialize %s: %w", b.Name, b.err)
+		}
+	}
+	return b.lang, b.err
+}
+
+// Language dispatches each file to the language that handles its extension, so
+// that files from different languages can be part of the same graph. Languages
+// are only initialized once a file that needs them is found.
+type Language struct {
+	cfg      *Config
+	backends []*backend
+	// owners tracks the backend of the files that do not have a known
+	// extension, but that some backend referenced, like Terraform's external
+	// modules.
+	owners   map[string]*backend
+	ownersMu sync.RWMutex
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+var _ io.Closer = &Language{}
+
+func MakeMixedLanguage(cfg *Config, backends []Backend) (language.Language, error) {
+	lang := Language{
+		cfg:    cfg,
+		owners: make(map[string]*backend),
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+	for _, b := range backends {
+		lang.backends = append(lang.backends, &backend{Backend: b})
+	}
+	return &lang, nil
+}
+
+func (l *Language) backendFor(path string) *backend {
+	for _, b := range l.backends {
+		if utils.EndsWith(path, b.Extensions) {
+			return b
+		}
+	}
+	l.ownersMu.RLock()
+	defer l.ownersMu.RUnlock()
+	return l.owners[path]
+}
+
+func (l *Language) backendByName(name string) *backend {
+	for _, b := range l.backends {
+		if b.Name == name {
+			return b
+		}
+	}
+	return nil
+}
+
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	b := l.backendFor(id)
+	if b == nil {
+		return nil, fmt.Errorf("file \"%s\" not supported", id)
+	}
+	lang, err := b.get(id)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := lang.ParseFile(id)
+	if err != nil {
+		return nil, err
+	}
+	// the backend might share the parsed file with other callers, so it's not modified.
+	file := *parsed
+	file.Language = b.Name
+	return &file, nil
+}
+
+// claim marks the paths referenced by a backend as owned by it, unless their
+// extension already tells which backend handles them.
+func (l *Language) claim(b *backend, absPath string) {
+	for _, other := range l.backends {
+		if utils.EndsWith(absPath, other.Extensions) {
+			return
+		}
+	}
+	l.ownersMu.Lock()
+	l.owners[absPath] = b
+	l.ownersMu.Unlock()
+}
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	b := l.backendByName(file.Language)
+	if b == nil {
+		return nil, fmt.Errorf("file \"%s\" was not parsed by any known language", file.AbsPath)
+	}
+	lang, err := b.get(file.AbsPath)
+	if err != nil {
+		return nil, err
+	}
+	result, err := lang.ParseImports(file)
+	if err != nil {
+		return nil, err
+	}
+	for _, imported := range result.Imports {
+		l.claim(b, imported.AbsPath)
+	}
+
+	for _, edge := range l.cfg.Edges {
+		if ok, _ := utils.GlobstarMatch(edge.From, file.AbsPath); !ok {
+			continue
+		}
+		for _, to := range edge.To {
+			matches, err := doublestar.FilepathGlob(to, doublestar.WithFilesOnly())
+			if err != nil || len(matches) == 0 {
+				result.Errors = append(result.Errors, fmt.Errorf("cross-language edge target %q does not match any file", to))
+				continue
+			}
+			for _, match := range matches {
+				if match = filepath.Clean(match); match != file.AbsPath {
+					result.Imports = append(result.Imports, language.EmptyImport(match))
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	b := l.backendByName(file.Language)
+	if b == nil {
+		return nil, fmt.Errorf("file \"%s\" was not parsed by any known language", file.AbsPath)
+	}
+	lang, err := b.get(file.AbsPath)
+	if err != nil {
+		return nil, err
+	}
+	result, err := lang.ParseExports(file)
+	if err != nil {
+		return nil, err
+	}
+	for _, exported := range result.Exports {
+		l.claim(b, exported.AbsPath)
+	}
+	return result, nil
+}
+
+func (l *Language) Invalidate(absPaths ...string) {
+	for _, b := range l.backends {
+		b.mu.Lock()
+		lang := b.lang
+		b.mu.Unlock()
+		if invalidator, ok := lang.(language.Invalidator); ok {
+			invalidator.Invalidate(absPaths...)
+		}
+	}
+}
+
+// Close closes the languages that were initialized, like the ones that spawned a plugin process.
+func (l *Language) Close() error {
+	var errs []error
+	for _, b := range l.backends {
+		b.mu.Lock()
+		lang := b.lang
+		b.mu.Unlock()
+		if closer, ok := lang.(io.Closer); ok {
+			errs = append(errs, closer.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,117 @@
+package mixed
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/css"
+	"github.com/gabotechs/dep-tree/internal/dummy"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".mixed_test"
+
+func testBackends() []Backend {
+	return []Backend{
+		{Name: "css", Extensions: css.Extensions, Make: func(string) (language.Language, error) {
+			return css.MakeCssLanguage(nil)
+		}},
+		{Name: "dummy", Extensions: dummy.Extensions, Make: func(string) (language.Language, error) {
+			return &dummy.Language{}, nil
+		}},
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+
+	tests := []struct {
+		Name             string
+		Edges            []Edge
+		File             string
+		ExpectedLanguage string
+		ExpectedDeps     []string
+		ExpectedErrors   []string
+	}{
+		{
+			Name:             "dispatches by extension",
+			File:             "main.dl",
+			ExpectedLanguage: "dummy",
+			ExpectedDeps:     []string{"lib.dl", "generated"},
+		},
+		{
+			Name:             "other languages",
+			File:             filepath.Join("styles", "app.css"),
+			ExpectedLanguage: "css",
+			ExpectedDeps:     []string{filepath.Join("styles", "base.css")},
+		},
+		{
+			Name: "cross-language edges",
+			Edges: []Edge{{
+				From: filepath.Join(absTestFolder, "**", "*.dl"),
+				To:   []string{filepath.Join(absTestFolder, "styles", "*.css"), filepath.Join(absTestFolder, "missing.css")},
+			}},
+			File:             "main.dl",
+			ExpectedLanguage: "dummy",
+			ExpectedDeps: []string{
+				"lib.dl",
+				"generated",
+				filepath.Join("styles", "app.css"),
+				filepath.Join("styles", "base.css"),
+			},
+			ExpectedErrors: []string{
+				"cross-language edge target \"" + filepath.Join(absTestFolder, "missing.css") + "\" does not match any file",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeMixedLanguage(&Config{Enabled: true, Edges: tt.Edges}, testBackends())
+			a.NoError(err)
+			parser := language.NewParser(lang)
+
+			node, err := parser.Node(filepath.Join(absTestFolder, tt.File))
+			a.NoError(err)
+			a.Equal(tt.ExpectedLanguage, node.Data.Language)
+
+			deps, err := parser.Deps(node)
+			a.NoError(err)
+			var result []string
+			for _, dep := range deps {
+				rel, _ := filepath.Rel(absTestFolder, dep.Data.AbsPath)
+				result = append(result, rel)
+			}
+			a.Equal(tt.ExpectedDeps, result)
+			var errs []string
+			for _, err := range node.Errors {
+				errs = append(errs, err.Error())
+			}
+			a.Equal(tt.ExpectedErrors, errs)
+		})
+	}
+}
+
+func TestLanguage_UnknownExtensions(t *testing.T) {
+	a := require.New(t)
+	absTestFolder, _ := filepath.Abs(testFolder)
+	lang, err := MakeMixedLanguage(nil, testBackends())
+	a.NoError(err)
+
+	// A file without a known extension only gets a language once it is
+	// referenced by a file of that language.
+	_, err = lang.ParseFile(filepath.Join(absTestFolder, "generated"))
+	a.ErrorContains(err, "not supported")
+
+	main, err := lang.ParseFile(filepath.Join(absTestFolder, "main.dl"))
+	a.NoError(err)
+	_, err = lang.ParseImports(main)
+	a.NoError(err)
+
+	generated, err := lang.ParseFile(filepath.Join(absTestFolder, "generated"))
+	a.NoError(err)
+	a.Equal("dummy", generated.Language)
+}
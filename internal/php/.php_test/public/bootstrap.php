@@ -0,0 +1,3 @@
+<?php
+
+error_reporting(E_ALL);
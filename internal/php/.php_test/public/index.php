@@ -0,0 +1,9 @@
+<!DOCTYPE html>
+<html>
+<?php
+require __DIR__ . '/../vendor/autoload.php';
+require 'bootstrap.php';
+?>
+<p>Don't panic</p>
+<?= App\Http\Controller::class ?>
+</html>
@@ -0,0 +1,8 @@
+<?php
+
+namespace App\Attributes;
+
+#[Attribute]
+class Queued
+{
+}
@@ -0,0 +1,8 @@
+<?php
+
+namespace App\Contracts;
+
+interface HasName
+{
+    public function name(): string;
+}
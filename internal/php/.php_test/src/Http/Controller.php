@@ -0,0 +1,16 @@
+<?php
+
+namespace App\Http;
+
+use App\Models\{User, Post as P};
+use App\Services;
+
+final class Controller
+{
+    public function show(): User
+    {
+        $mailer = new Services\Mailer();
+        require_once __DIR__ . '/../helpers.php';
+        return new User();
+    }
+}
@@ -0,0 +1,7 @@
+<?php
+
+namespace App\Models;
+
+trait Timestamps
+{
+}
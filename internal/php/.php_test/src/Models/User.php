@@ -0,0 +1,15 @@
+<?php
+
+namespace App\Models;
+
+use App\Contracts\HasName;
+
+class User implements HasName
+{
+    use Timestamps;
+
+    public function name(): string
+    {
+        return Helpers\format($this->name);
+    }
+}
@@ -0,0 +1,8 @@
+<?php
+
+namespace App\Services;
+
+#[\App\Attributes\Queued]
+class Mailer
+{
+}
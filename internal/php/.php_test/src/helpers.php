@@ -0,0 +1,6 @@
+<?php
+
+function format(string $value): string
+{
+    return ucfirst($value);
+}
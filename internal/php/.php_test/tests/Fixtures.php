@@ -0,0 +1,10 @@
+<?php
+
+namespace App\Tests;
+
+class Fixtures
+{
+    public static function load(): void
+    {
+    }
+}
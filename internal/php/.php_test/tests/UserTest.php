@@ -0,0 +1,15 @@
+<?php
+
+namespace App\Tests;
+
+use App\Models\User;
+use PHPUnit\Framework\TestCase;
+
+class UserTest extends TestCase
+{
+    public function testName(): void
+    {
+        Fixtures::load();
+        $this->assertInstanceOf(User::class, new User());
+    }
+}
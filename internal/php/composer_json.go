@@ -0,0 +1,97 @@
+package php
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+const composerJsonFile = "composer.json"
+
+type autoload struct {
+	// Psr4 maps namespace prefixes to one or more directories.
+	Psr4 map[string]any `json:"psr-4"`
+}
+
+type composerJson struct {
+	absPath     string
+	Name        string   `json:"name"`
+	Autoload    autoload `json:"autoload"`
+	AutoloadDev autoload `json:"autoload-dev"`
+	psr4        []psr4Entry
+}
+
+var findComposerJson = utils.MakeCachedFindClosestDirWithRootFile([]string{composerJsonFile})
+
+var readComposerJson, evictComposerJson = utils.EvictableCached1In2Out(func(dir string) (*composerJson, error) {
+	fullPath := filepath.Join(dir, composerJsonFile)
+	content, err := os.ReadFile(fullPath)
+	if err != nil {
+		return nil, err
+	}
+	var result composerJson
+	err = json.Unmarshal(content, &result)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %q: %w", fullPath, err)
+	}
+	result.absPath = dir
+	result.psr4 = result.psr4Entries()
+	return &result, nil
+})
+
+type psr4Entry struct {
+	Prefix string
+	Dirs   []string
+}
+
+// psr4Entries returns the PSR-4 autoload entries, both from autoload and autoload-dev,
+// sorted so that the most specific namespace prefixes come first.
+func (c *composerJson) psr4Entries() []psr4Entry {
+	var result []psr4Entry
+	for _, section := range []autoload{c.Autoload, c.AutoloadDev} {
+		for prefix, dirs := range section.Psr4 {
+			entry := psr4Entry{Prefix: strings.TrimPrefix(prefix, `\`)}
+			switch v := dirs.(type) {
+			case string:
+				entry.Dirs = []string{filepath.Join(c.absPath, v)}
+			case []any:
+				for _, dir := range v {
+					if dir, ok := dir.(string); ok {
+						entry.Dirs = append(entry.Dirs, filepath.Join(c.absPath, dir))
+					}
+				}
+			}
+			result = append(result, entry)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if len(result[i].Prefix) != len(result[j].Prefix) {
+			return len(result[i].Prefix) > len(result[j].Prefix)
+		}
+		return result[i].Prefix < result[j].Prefix
+	})
+	return result
+}
+
+// resolveClass resolves a fully qualified class name to the file where it should
+// be declared according to PSR-4, or an empty string if no file exists.
+func (c *composerJson) resolveClass(name string) string {
+	for _, entry := range c.psr4 {
+		if !strings.HasPrefix(name, entry.Prefix) {
+			continue
+		}
+		rel := strings.ReplaceAll(strings.TrimPrefix(name, entry.Prefix), `\`, string(os.PathSeparator))
+		for _, dir := range entry.Dirs {
+			candidate := filepath.Join(dir, rel) + ".php"
+			if utils.FileExists(candidate) {
+				return candidate
+			}
+		}
+	}
+	return ""
+}
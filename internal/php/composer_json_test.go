@@ -0,0 +1,44 @@
+package php
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComposerJson_ResolveClass(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+
+	tests := []struct {
+		Name     string
+		Expected string
+	}{
+		{
+			Name:     `App\Models\User`,
+			Expected: filepath.Join(absTestFolder, "src", "Models", "User.php"),
+		},
+		{
+			Name:     `App\Tests\Fixtures`,
+			Expected: filepath.Join(absTestFolder, "tests", "Fixtures.php"),
+		},
+		{
+			Name:     `App\Models\Missing`,
+			Expected: "",
+		},
+		{
+			Name:     `Vendor\Package\Foo`,
+			Expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			composer, err := readComposerJson(absTestFolder)
+			a.NoError(err)
+			a.Equal("acme/app", composer.Name)
+			a.Equal(tt.Expected, composer.resolveClass(tt.Name))
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package php
+
+type Config struct{}
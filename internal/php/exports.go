@@ -0,0 +1,35 @@
+package php
+
+import (
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/php/php_grammar"
+)
+
+func collectDeclarations(statements []*php_grammar.Statement) []language.ExportSymbol {
+	var result []language.ExportSymbol
+	for _, stmt := range statements {
+		switch {
+		case stmt.Declaration != nil && !stmt.Declaration.Anonymous():
+			result = append(result, language.ExportSymbol{Original: stmt.Declaration.Name})
+		case stmt.Namespace != nil && stmt.Namespace.Body != nil:
+			result = append(result, collectDeclarations(stmt.Namespace.Body.Statements)...)
+		}
+	}
+	return result
+}
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	exports := make([]language.ExportEntry, 0)
+
+	symbols := collectDeclarations(file.Content.(*php_grammar.File).Statements)
+	if len(symbols) > 0 {
+		exports = append(exports, language.ExportEntry{
+			Symbols: symbols,
+			AbsPath: file.AbsPath,
+		})
+	}
+
+	return &language.ExportsResult{
+		Exports: exports,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package php
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func TestLanguage_ParseExports(t *testing.T) {
+	src, _ := filepath.Abs(filepath.Join(testFolder, "src"))
+
+	tests := []struct {
+		Name     string
+		Expected []language.ExportEntry
+	}{
+		{
+			Name: filepath.Join("Models", "User.php"),
+			Expected: []language.ExportEntry{{
+				Symbols: []language.ExportSymbol{{Original: "User"}},
+				AbsPath: filepath.Join(src, "Models", "User.php"),
+			}},
+		},
+		{
+			Name:     "helpers.php",
+			Expected: []language.ExportEntry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakePhpLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(filepath.Join(src, tt.Name))
+			a.NoError(err)
+
+			exports, err := lang.ParseExports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, exports.Exports)
+		})
+	}
+}
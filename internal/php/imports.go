@@ -0,0 +1,140 @@
+package php
+
+import (
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/php/php_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+type importsCollector struct {
+	file     *language.FileInfo
+	composer *composerJson
+	imports  []language.ImportEntry
+	seen     map[string]struct{}
+	// namespace is the namespace in which the statements being visited are.
+	namespace string
+	// aliases maps the names imported with `use` statements to their fully
+	// qualified name.
+	aliases map[string]string
+	// pos is the position of the statement being visited.
+	pos lexer.Position
+}
+
+func (c *importsCollector) add(entry language.ImportEntry) {
+	if _, ok := c.seen[entry.AbsPath]; ok || entry.AbsPath == "" {
+		return
+	}
+	c.seen[entry.AbsPath] = struct{}{}
+	entry.Line, entry.Column = c.pos.Line, c.pos.Column
+	c.imports = append(c.imports, entry)
+}
+
+// qualify returns the fully qualified name of a class name referenced in the
+// code, following PHP's name resolution rules.
+func (c *importsCollector) qualify(name string) string {
+	if strings.HasPrefix(name, `\`) {
+		return strings.TrimPrefix(name, `\`)
+	}
+	first, rest, qualified := strings.Cut(name, `\`)
+	if alias, ok := c.aliases[first]; ok {
+		if qualified {
+			return alias + `\` + rest
+		}
+		return alias
+	}
+	if c.namespace == "" {
+		return name
+	}
+	return c.namespace + `\` + name
+}
+
+func (c *importsCollector) addClass(name string) {
+	if c.composer == nil {
+		return
+	}
+	absPath := c.composer.resolveClass(name)
+	if absPath == "" || absPath == c.file.AbsPath {
+		return
+	}
+	c.add(language.SymbolsImport([]string{name[strings.LastIndex(name, `\`)+1:]}, absPath))
+}
+
+func (c *importsCollector) addRequire(require *php_grammar.Require) {
+	path := require.Path
+	if !filepath.IsAbs(path) || require.Dir {
+		path = filepath.Join(filepath.Dir(c.file.AbsPath), path)
+	}
+	if utils.FileExists(path) {
+		c.add(language.EmptyImport(path))
+	} else if c.composer != nil && !require.Dir && utils.FileExists(filepath.Join(c.composer.absPath, require.Path)) {
+		c.add(language.EmptyImport(filepath.Join(c.composer.absPath, require.Path)))
+	}
+}
+
+// visit collects the imports from statements. The `use` statements at the top level
+// import names, but the ones nested in class bodies import traits.
+func (c *importsCollector) visit(statements []*php_grammar.Statement, topLevel bool) {
+	for _, stmt := range statements {
+		c.pos = stmt.Pos
+		switch {
+		case stmt.Namespace != nil:
+			c.namespace = strings.TrimPrefix(stmt.Namespace.Name, `\`)
+			c.aliases = map[string]string{}
+			if stmt.Namespace.Body != nil {
+				c.visit(stmt.Namespace.Body.Statements, topLevel)
+			}
+		case stmt.Use != nil && topLevel:
+			for _, used := range stmt.Use.Names() {
+				if used.Kind != "" {
+					// Functions and constants are not autoloaded.
+					continue
+				}
+				c.aliases[used.Alias] = used.Name
+				c.addClass(used.Name)
+			}
+		case stmt.Use != nil:
+			for _, used := range stmt.Use.Names() {
+				c.addClass(c.qualify(used.Name))
+			}
+		case stmt.Require != nil:
+			c.addRequire(stmt.Require)
+		case stmt.Block != nil:
+			c.visit(stmt.Block.Statements, false)
+		case stmt.Reference != "":
+			// Classes are conventionally capitalized, so avoid looking up for
+			// keywords and function calls.
+			name := stmt.Reference[strings.LastIndex(stmt.Reference, `\`)+1:]
+			if name != "" && unicode.IsUpper(rune(name[0])) {
+				c.addClass(c.qualify(stmt.Reference))
+			}
+		}
+	}
+}
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	collector := importsCollector{
+		file:    file,
+		imports: make([]language.ImportEntry, 0),
+		seen:    map[string]struct{}{},
+		aliases: map[string]string{},
+	}
+	var errors []error
+	if root := findComposerJson(filepath.Dir(file.AbsPath)); root != nil {
+		composer, err := readComposerJson(root.AbsDir)
+		if err != nil {
+			errors = append(errors, err)
+		}
+		collector.composer = composer
+	}
+	collector.visit(file.Content.(*php_grammar.File).Statements, true)
+
+	return &language.ImportsResult{
+		Imports: collector.imports,
+		Errors:  errors,
+	}, nil
+}
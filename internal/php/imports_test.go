@@ -0,0 +1,85 @@
+package php
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".php_test"
+
+func TestLanguage_ParseImports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+	src := filepath.Join(absTestFolder, "src")
+
+	tests := []struct {
+		Name     string
+		File     string
+		Expected []language.ImportEntry
+	}{
+		{
+			Name: "use statements and same namespace traits",
+			File: filepath.Join(src, "Models", "User.php"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"HasName"}, filepath.Join(src, "Contracts", "HasName.php")), 5, 1),
+				at(language.SymbolsImport([]string{"Timestamps"}, filepath.Join(src, "Models", "Timestamps.php")), 9, 5),
+			},
+		},
+		{
+			Name: "group use, aliased namespaces and requires",
+			File: filepath.Join(src, "Http", "Controller.php"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"User"}, filepath.Join(src, "Models", "User.php")), 5, 1),
+				at(language.SymbolsImport([]string{"Mailer"}, filepath.Join(src, "Services", "Mailer.php")), 12, 23),
+				at(language.EmptyImport(filepath.Join(src, "helpers.php")), 13, 9),
+			},
+		},
+		{
+			Name: "fully qualified attributes",
+			File: filepath.Join(src, "Services", "Mailer.php"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"Queued"}, filepath.Join(src, "Attributes", "Queued.php")), 5, 3),
+			},
+		},
+		{
+			Name: "autoload-dev",
+			File: filepath.Join(absTestFolder, "tests", "UserTest.php"),
+			Expected: []language.ImportEntry{
+				at(language.SymbolsImport([]string{"User"}, filepath.Join(src, "Models", "User.php")), 5, 1),
+				at(language.SymbolsImport([]string{"Fixtures"}, filepath.Join(absTestFolder, "tests", "Fixtures.php")), 12, 9),
+			},
+		},
+		{
+			Name: "inline html",
+			File: filepath.Join(absTestFolder, "public", "index.php"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "public", "bootstrap.php")), 5, 1),
+				at(language.SymbolsImport([]string{"Controller"}, filepath.Join(src, "Http", "Controller.php")), 8, 5),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakePhpLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			imports, err := lang.ParseImports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, imports.Imports)
+			a.Nil(imports.Errors)
+		})
+	}
+}
+
+func at(entry language.ImportEntry, line, column int) language.ImportEntry {
+	entry.Line, entry.Column = line, column
+	return entry
+}
@@ -0,0 +1,55 @@
+package php
+
+import (
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/php/php_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var Extensions = []string{
+	"php",
+}
+
+type Language struct {
+	cfg *Config
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+
+func MakePhpLanguage(cfg *Config) (language.Language, error) {
+	lang := Language{
+		cfg: cfg,
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+	return &lang, nil
+}
+
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	file, err := php_grammar.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	root := findComposerJson(filepath.Dir(id))
+	if root == nil {
+		return file, nil
+	}
+	if composer, err := readComposerJson(root.AbsDir); err == nil {
+		file.Package = composer.Name
+	}
+	file.RelPath, _ = filepath.Rel(root.AbsDir, id)
+	return file, nil
+}
+
+// Invalidate drops the composer.json files that are among the provided files, so that
+// their autoload settings are read again.
+func (l *Language) Invalidate(absPaths ...string) {
+	isAnyOf := utils.IsAnyOf(absPaths)
+	evictComposerJson(func(dir string) bool {
+		return isAnyOf(filepath.Join(dir, composerJsonFile))
+	})
+}
@@ -0,0 +1,13 @@
+//nolint:govet
+package php_grammar
+
+// Declaration is a class like declaration, like `final class Foo`.
+type Declaration struct {
+	Kind string `@("class" | "interface" | "trait" | "enum")`
+	Name string `@Name`
+}
+
+// Anonymous classes, like `new class extends Foo {}`, do not declare a name.
+func (d *Declaration) Anonymous() bool {
+	return d.Name == "extends" || d.Name == "implements"
+}
@@ -0,0 +1,75 @@
+//nolint:govet
+package php_grammar
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+type Statement struct {
+	Pos         lexer.Position
+	Namespace   *Namespace   `  @@`
+	Use         *Use         `| @@`
+	Require     *Require     `| @@`
+	Declaration *Declaration `| @@`
+	Block       *Block       `| @@`
+	// Reference is any name used in the code, which might reference a class.
+	Reference string `| @Name`
+}
+
+// Block holds the statements between braces, like the ones of a class body.
+type Block struct {
+	Statements []*Statement `"{" (@@ | ANY | Punct | Var | String | HTML)* "}"`
+}
+
+type File struct {
+	Statements []*Statement `(@@ | ANY | Punct | Brace | Var | String | HTML)*`
+}
+
+var (
+	lex = lexer.MustSimple(
+		[]lexer.SimpleRule{
+			// HTML is anything outside the <?php ?> tags.
+			{"HTML", `\?>(?:.|\n)*?(?:<\?php|<\?=|<\?|\z)`},
+			{"Comment", `//.*|/\*(.|\n)*?\*/|#(?:[^\[\n].*)?`},
+			{"String", `"(?:\\.|[^"\\])*"` + "|" + `'(?:\\.|[^'\\])*'`},
+			{"Var", `\$[_a-zA-Z][_a-zA-Z0-9]*`},
+			{"Name", `\\?[_a-zA-Z][_a-zA-Z0-9]*(?:\\[_a-zA-Z][_a-zA-Z0-9]*)*\\?`},
+			{"Brace", `[{}]`},
+			{"Punct", `[.,;:()\[\]=<>+\-*/%&|^~!?@$\\]`},
+			{"Whitespace", `\s+`},
+			{"ANY", `.`},
+		},
+	)
+	parser = participle.MustBuild[File](
+		participle.Lexer(lex),
+		participle.Elide("Whitespace", "Comment"),
+		utils.UnquoteSafe("String"),
+		participle.UseLookahead(1024),
+	)
+)
+
+// htmlPrefix makes the lexer treat anything before the first <?php tag as HTML.
+var htmlPrefix = []byte("?>")
+
+func Parse(filePath string) (*language.FileInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseBytes(filePath, append(htmlPrefix, content...))
+	if err != nil {
+		return nil, err
+	}
+	return &language.FileInfo{
+		Content: file,
+		Loc:     bytes.Count(content, []byte("\n")),
+		Size:    len(content),
+		AbsPath: filePath,
+	}, nil
+}
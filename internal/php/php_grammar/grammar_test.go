@@ -0,0 +1,102 @@
+package php_grammar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type flatStatement struct {
+	Depth     int
+	Namespace string
+	Uses      []UsedName
+	Require   *Require
+	Decl      string
+	Reference string
+}
+
+func flatten(statements []*Statement, depth int) []flatStatement {
+	var result []flatStatement
+	for _, stmt := range statements {
+		switch {
+		case stmt.Namespace != nil:
+			result = append(result, flatStatement{Depth: depth, Namespace: stmt.Namespace.Name})
+			if stmt.Namespace.Body != nil {
+				result = append(result, flatten(stmt.Namespace.Body.Statements, depth)...)
+			}
+		case stmt.Use != nil:
+			result = append(result, flatStatement{Depth: depth, Uses: stmt.Use.Names()})
+		case stmt.Require != nil:
+			result = append(result, flatStatement{Depth: depth, Require: stmt.Require})
+		case stmt.Declaration != nil:
+			result = append(result, flatStatement{Depth: depth, Decl: stmt.Declaration.Kind + " " + stmt.Declaration.Name})
+		case stmt.Block != nil:
+			result = append(result, flatten(stmt.Block.Statements, depth+1)...)
+		case stmt.Reference != "":
+			result = append(result, flatStatement{Depth: depth, Reference: stmt.Reference})
+		}
+	}
+	return result
+}
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Expected []flatStatement
+	}{
+		{
+			Name: "<?php\nnamespace App\\Http;\n\nuse App\\Models\\User;\nuse App\\Models\\{Post, Comment as C};\nuse function App\\helper;",
+			Expected: []flatStatement{
+				{Namespace: `App\Http`},
+				{Uses: []UsedName{{Name: `App\Models\User`, Alias: "User"}}},
+				{Uses: []UsedName{{Name: `App\Models\Post`, Alias: "Post"}, {Name: `App\Models\Comment`, Alias: "C"}}},
+				{Uses: []UsedName{{Kind: "function", Name: `App\helper`, Alias: "helper"}}},
+			},
+		},
+		{
+			Name: "<?php\nfinal class Foo extends Bar {\n  use Baz;\n  public function f(): \\Qux { return new class extends Bar {}; }\n}",
+			Expected: []flatStatement{
+				{Reference: "final"},
+				{Decl: "class Foo"},
+				{Reference: "extends"},
+				{Reference: "Bar"},
+				{Depth: 1, Uses: []UsedName{{Name: "Baz", Alias: "Baz"}}},
+				{Depth: 1, Reference: "public"},
+				{Depth: 1, Reference: "function"},
+				{Depth: 1, Reference: "f"},
+				{Depth: 1, Reference: `\Qux`},
+				{Depth: 2, Reference: "return"},
+				{Depth: 2, Reference: "new"},
+				{Depth: 2, Decl: "class extends"},
+				{Depth: 2, Reference: "Bar"},
+			},
+		},
+		{
+			Name: "<?php\nrequire_once __DIR__ . '/a.php';\ninclude('b.php');\nrequire dirname(__FILE__) . \"/c.php\";",
+			Expected: []flatStatement{
+				{Require: &Require{Kind: "require_once", Dir: true, Path: "/a.php"}},
+				{Require: &Require{Kind: "include", Path: "b.php"}},
+				{Require: &Require{Kind: "require", Dir: true, Path: "/c.php"}},
+			},
+		},
+		{
+			Name: "<p>don't <?php echo Foo::class ?></p>\n<?= $bar // Baz\n?> it's\n# not <?php #[Attr]\nnamespace A { /* B */ }",
+			Expected: []flatStatement{
+				{Reference: "echo"},
+				{Reference: "Foo"},
+				{Reference: "class"},
+				{Reference: "Attr"},
+				{Namespace: "A"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			parsed, err := parser.ParseBytes("", append(htmlPrefix, []byte(tt.Name)...))
+			a.NoError(err)
+			a.Equal(tt.Expected, flatten(parsed.Statements, 0))
+		})
+	}
+}
@@ -0,0 +1,9 @@
+//nolint:govet
+package php_grammar
+
+type Namespace struct {
+	Name string `"namespace" @Name?`
+	// Body is only present in namespaces declared with braces, otherwise the
+	// namespace applies to all the statements that follow it.
+	Body *Block `( ";" | @@ )`
+}
@@ -0,0 +1,10 @@
+//nolint:govet
+package php_grammar
+
+// Require is a require or include statement of a literal path, optionally
+// relative to the current dir, like `require_once __DIR__ . '/foo.php';`.
+type Require struct {
+	Kind string `@("require" | "require_once" | "include" | "include_once") "("?`
+	Dir  bool   `(@("__DIR__" | "dirname" "(" "__FILE__" ")") ".")?`
+	Path string `@String`
+}
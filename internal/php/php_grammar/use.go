@@ -0,0 +1,50 @@
+//nolint:govet
+package php_grammar
+
+import "strings"
+
+type UseClause struct {
+	Kind  string `@("function" | "const")?`
+	Name  string `@Name`
+	Alias string `("as" @Name)?`
+}
+
+// Use is an import statement, like `use Foo\Bar as Baz;` or `use Foo\{Bar, Baz};`.
+// Inside class bodies, they import traits instead.
+type Use struct {
+	Kind         string       `"use" @("function" | "const")?`
+	Group        string       `( @Name "{"`
+	GroupClauses []*UseClause `  @@ ("," @@)* ","? "}"`
+	Clauses      []*UseClause `| @@ ("," @@)* ) ";"`
+}
+
+type UsedName struct {
+	Kind  string
+	Name  string
+	Alias string
+}
+
+// Names returns the fully qualified names imported by the statement, along with
+// the name under which they are imported.
+func (u *Use) Names() []UsedName {
+	result := make([]UsedName, 0, len(u.Clauses)+len(u.GroupClauses))
+	clauses := u.Clauses
+	prefix := ""
+	if u.Group != "" {
+		clauses = u.GroupClauses
+		prefix = strings.TrimSuffix(u.Group, `\`) + `\`
+	}
+	for _, clause := range clauses {
+		name := strings.TrimPrefix(prefix+clause.Name, `\`)
+		alias := clause.Alias
+		if alias == "" {
+			alias = name[strings.LastIndex(name, `\`)+1:]
+		}
+		kind := u.Kind
+		if clause.Kind != "" {
+			kind = clause.Kind
+		}
+		result = append(result, UsedName{Kind: kind, Name: name, Alias: alias})
+	}
+	return result
+}
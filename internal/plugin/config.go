@@ -0,0 +1,11 @@
+package plugin
+
+// Config registers an external executable as the language for some file extensions.
+type Config struct {
+	// Command is the executable that implements the plugin protocol.
+	Command string `yaml:"command"`
+	// Args are passed to the executable when it's spawned.
+	Args []string `yaml:"args"`
+	// Extensions are the file extensions handled by the plugin.
+	Extensions []string `yaml:"extensions"`
+}
@@ -0,0 +1,22 @@
+// This is a reference plugin that serves the Dummy Language through the plugin
+// protocol. It can be registered in the config file with:
+//
+//	plugins:
+//	  - command: dummy-plugin
+//	    extensions: ['dl']
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gabotechs/dep-tree/internal/dummy"
+	"github.com/gabotechs/dep-tree/internal/plugin"
+)
+
+func main() {
+	if err := plugin.Serve(&dummy.Language{}, os.Stdin, os.Stdout); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
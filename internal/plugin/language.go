@@ -0,0 +1,155 @@
+package plugin
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+// Language is a language.Language implemented by an external process.
+type Language struct {
+	cfg    *Config
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Scanner
+	mu     sync.Mutex
+	nextId int
+	// failed is the protocol error after which the plugin's process was killed, as
+	// there is no telling which request the rest of its output belongs to.
+	failed error
+}
+
+var _ language.Language = &Language{}
+var _ io.Closer = &Language{}
+
+// MakePluginLanguage spawns the plugin's executable, which keeps running until
+// Close is called or Dep Tree exits.
+func MakePluginLanguage(cfg *Config) (*Language, error) {
+	if cfg == nil || cfg.Command == "" {
+		return nil, errors.New("a command must be provided for the plugin")
+	}
+	cmd := exec.Command(cfg.Command, cfg.Args...)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, fmt.Errorf("could not start plugin %s: %w", cfg.Command, err)
+	}
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	return &Language{
+		cfg:    cfg,
+		cmd:    cmd,
+		stdin:  stdin,
+		stdout: scanner,
+	}, nil
+}
+
+// Close terminates the plugin's process by closing its stdin. Plugins that were
+// killed after a protocol error are only waited for, as their error was already reported.
+func (l *Language) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.stdin.Close(); err != nil && l.failed == nil {
+		return fmt.Errorf("could not close plugin %s: %w", l.cfg.Command, err)
+	}
+	if err := l.cmd.Wait(); err != nil && l.failed == nil {
+		return fmt.Errorf("plugin %s did not exit cleanly: %w", l.cfg.Command, err)
+	}
+	return nil
+}
+
+// fail kills the plugin's process, and makes all the following requests fail with err.
+func (l *Language) fail(err error) error {
+	l.failed = err
+	_ = l.cmd.Process.Kill()
+	return err
+}
+
+func (l *Language) call(method string, path string) (*Response, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.failed != nil {
+		return nil, l.failed
+	}
+
+	l.nextId++
+	request, err := json.Marshal(Request{Id: l.nextId, Method: method, Path: path})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = l.stdin.Write(append(request, '\n')); err != nil {
+		return nil, l.fail(fmt.Errorf("plugin %s is not accepting requests: %w", l.cfg.Command, err))
+	}
+	if !l.stdout.Scan() {
+		if err = l.stdout.Err(); err == nil {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, l.fail(fmt.Errorf("plugin %s did not respond: %w", l.cfg.Command, err))
+	}
+	var response Response
+	if err = json.Unmarshal(l.stdout.Bytes(), &response); err != nil {
+		return nil, l.fail(fmt.Errorf("plugin %s sent an invalid response: %w", l.cfg.Command, err))
+	}
+	if response.Id != l.nextId {
+		return nil, l.fail(fmt.Errorf("plugin %s responded to request %d while %d was expected", l.cfg.Command, response.Id, l.nextId))
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+	return &response, nil
+}
+
+func (l *Language) ParseFile(path string) (*language.FileInfo, error) {
+	response, err := l.call(MethodParseFile, path)
+	if err != nil {
+		return nil, err
+	}
+	if response.File == nil {
+		return nil, fmt.Errorf("plugin %s did not return a file for %s", l.cfg.Command, path)
+	}
+	return &language.FileInfo{
+		AbsPath:       path,
+		RelPath:       response.File.RelPath,
+		Package:       response.File.Package,
+		Loc:           response.File.Loc,
+		Size:          response.File.Size,
+		Types:         response.File.Types,
+		AbstractTypes: response.File.AbstractTypes,
+	}, nil
+}
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	response, err := l.call(MethodParseImports, file.AbsPath)
+	if err != nil {
+		return nil, err
+	}
+	if response.Imports == nil {
+		return &language.ImportsResult{}, nil
+	}
+	return response.Imports.toResult(), nil
+}
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	response, err := l.call(MethodParseExports, file.AbsPath)
+	if err != nil {
+		return nil, err
+	}
+	if response.Exports == nil {
+		return &language.ExportsResult{}, nil
+	}
+	return response.Exports.toResult(), nil
+}
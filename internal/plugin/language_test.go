@@ -0,0 +1,104 @@
+package plugin
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".plugin_test"
+
+// buildDummyPlugin compiles the reference plugin, so that tests can spawn it.
+func buildDummyPlugin(t *testing.T) string {
+	bin := filepath.Join(t.TempDir(), "dummy-plugin")
+	out, err := exec.Command("go", "build", "-o", bin, "./dummy-plugin").CombinedOutput()
+	require.NoError(t, err, string(out))
+	return bin
+}
+
+func TestLanguage(t *testing.T) {
+	a := require.New(t)
+	bin := buildDummyPlugin(t)
+	absTestFolder, _ := filepath.Abs(testFolder)
+
+	lang, err := MakePluginLanguage(&Config{Command: bin, Extensions: []string{"dl"}})
+	a.NoError(err)
+	defer func() { a.NoError(lang.Close()) }()
+
+	file, err := lang.ParseFile(filepath.Join(absTestFolder, "main.dl"))
+	a.NoError(err)
+	a.Equal(filepath.Join(absTestFolder, "main.dl"), file.AbsPath)
+	a.Equal(4, file.Loc)
+
+	imports, err := lang.ParseImports(file)
+	a.NoError(err)
+	a.Equal([]language.ImportEntry{
+		{Symbols: []string{"foo"}, AbsPath: filepath.Join(absTestFolder, "lib.dl")},
+		{Symbols: []string{"bar"}, AbsPath: filepath.Join(absTestFolder, "missing.dl")},
+	}, imports.Imports)
+
+	exports, err := lang.ParseExports(file)
+	a.NoError(err)
+	a.Equal([]language.ExportEntry{
+		{Symbols: []language.ExportSymbol{{Original: "baz"}}, AbsPath: filepath.Join(absTestFolder, "main.dl")},
+	}, exports.Exports)
+
+	_, err = lang.ParseFile(filepath.Join(absTestFolder, "missing.dl"))
+	a.ErrorContains(err, "no such file or directory")
+}
+
+func TestLanguage_Parser(t *testing.T) {
+	a := require.New(t)
+	bin := buildDummyPlugin(t)
+	absTestFolder, _ := filepath.Abs(testFolder)
+
+	lang, err := MakePluginLanguage(&Config{Command: bin, Extensions: []string{"dl"}})
+	a.NoError(err)
+	defer func() { a.NoError(lang.Close()) }()
+
+	parser := language.NewParser(lang)
+	node, err := parser.Node(filepath.Join(absTestFolder, "main.dl"))
+	a.NoError(err)
+	deps, err := parser.Deps(node)
+	a.NoError(err)
+	var result []string
+	for _, dep := range deps {
+		result = append(result, dep.Data.AbsPath)
+	}
+	a.Equal([]string{filepath.Join(absTestFolder, "lib.dl")}, result)
+	a.Len(node.Errors, 1)
+}
+
+func TestLanguage_ProtocolError(t *testing.T) {
+	a := require.New(t)
+	absTestFolder, _ := filepath.Abs(testFolder)
+
+	// a plugin that answers the first request with something that is not a response,
+	// and then keeps running.
+	lang, err := MakePluginLanguage(&Config{Command: "sh", Args: []string{"-c", "read request; echo not-json; exec cat > /dev/null"}})
+	a.NoError(err)
+
+	_, err = lang.ParseFile(filepath.Join(absTestFolder, "main.dl"))
+	a.ErrorContains(err, "sent an invalid response")
+	// the following requests are not sent, as the plugin is out of sync.
+	_, other := lang.ParseFile(filepath.Join(absTestFolder, "lib.dl"))
+	a.Equal(err, other)
+
+	a.NoError(lang.Close())
+	a.False(lang.cmd.ProcessState.Success())
+}
+
+func TestMakePluginLanguage(t *testing.T) {
+	a := require.New(t)
+
+	_, err := MakePluginLanguage(&Config{})
+	a.ErrorContains(err, "a command must be provided")
+
+	_, err = MakePluginLanguage(&Config{Command: filepath.Join(os.TempDir(), "not-a-plugin")})
+	a.ErrorContains(err, "could not start plugin")
+}
@@ -0,0 +1,161 @@
+package plugin
+
+import (
+	"errors"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+// The plugin protocol mirrors the language.Language interface. Dep Tree spawns
+// the plugin's executable once, and sends one JSON encoded Request per line
+// through its stdin. The plugin must answer each one of them with a single JSON
+// encoded Response in one line through its stdout, in the same order.
+
+const (
+	MethodParseFile    = "parseFile"
+	MethodParseImports = "parseImports"
+	MethodParseExports = "parseExports"
+)
+
+type Request struct {
+	Id     int    `json:"id"`
+	Method string `json:"method"`
+	// Path is the absolute path of the file that should be processed.
+	Path string `json:"path"`
+}
+
+type Response struct {
+	Id int `json:"id"`
+	// Error is set if the request could not be fulfilled.
+	Error string `json:"error,omitempty"`
+	// File is the response to a parseFile request.
+	File *File `json:"file,omitempty"`
+	// Imports is the response to a parseImports request.
+	Imports *Imports `json:"imports,omitempty"`
+	// Exports is the response to a parseExports request.
+	Exports *Exports `json:"exports,omitempty"`
+}
+
+type File struct {
+	RelPath string `json:"relPath"`
+	Package string `json:"package"`
+	Loc     int    `json:"loc"`
+	Size    int    `json:"size"`
+	// Types and AbstractTypes tell how many types the file declares, and how many of
+	// them are abstract, like interfaces or abstract classes.
+	Types         int `json:"types,omitempty"`
+	AbstractTypes int `json:"abstractTypes,omitempty"`
+}
+
+type Import struct {
+	All     bool     `json:"all,omitempty"`
+	Symbols []string `json:"symbols,omitempty"`
+	Path    string   `json:"path"`
+	// TypeOnly is true if only types are imported.
+	TypeOnly bool `json:"typeOnly,omitempty"`
+	Line     int  `json:"line,omitempty"`
+	Column   int  `json:"column,omitempty"`
+}
+
+type Imports struct {
+	Imports []Import `json:"imports"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+type ExportSymbol struct {
+	Original string `json:"original"`
+	Alias    string `json:"alias,omitempty"`
+}
+
+type Export struct {
+	All     bool           `json:"all,omitempty"`
+	Symbols []ExportSymbol `json:"symbols,omitempty"`
+	Path    string         `json:"path"`
+}
+
+type Exports struct {
+	Exports []Export `json:"exports"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+func toErrors(messages []string) []error {
+	var result []error
+	for _, msg := range messages {
+		result = append(result, errors.New(msg))
+	}
+	return result
+}
+
+func fromErrors(errs []error) []string {
+	var result []string
+	for _, err := range errs {
+		result = append(result, err.Error())
+	}
+	return result
+}
+
+func (i *Imports) toResult() *language.ImportsResult {
+	result := language.ImportsResult{
+		Imports: make([]language.ImportEntry, len(i.Imports)),
+		Errors:  toErrors(i.Errors),
+	}
+	for j, entry := range i.Imports {
+		result.Imports[j] = language.ImportEntry{
+			All:      entry.All,
+			Symbols:  entry.Symbols,
+			AbsPath:  entry.Path,
+			TypeOnly: entry.TypeOnly,
+			Line:     entry.Line,
+			Column:   entry.Column,
+		}
+	}
+	return &result
+}
+
+func fromImportsResult(result *language.ImportsResult) *Imports {
+	imports := Imports{
+		Imports: make([]Import, len(result.Imports)),
+		Errors:  fromErrors(result.Errors),
+	}
+	for i, entry := range result.Imports {
+		imports.Imports[i] = Import{
+			All:      entry.All,
+			Symbols:  entry.Symbols,
+			Path:     entry.AbsPath,
+			TypeOnly: entry.TypeOnly,
+			Line:     entry.Line,
+			Column:   entry.Column,
+		}
+	}
+	return &imports
+}
+
+func (e *Exports) toResult() *language.ExportsResult {
+	result := language.ExportsResult{
+		Exports: make([]language.ExportEntry, len(e.Exports)),
+		Errors:  toErrors(e.Errors),
+	}
+	for i, entry := range e.Exports {
+		var symbols []language.ExportSymbol
+		for _, symbol := range entry.Symbols {
+			symbols = append(symbols, language.ExportSymbol{Original: symbol.Original, Alias: symbol.Alias})
+		}
+		result.Exports[i] = language.ExportEntry{All: entry.All, Symbols: symbols, AbsPath: entry.Path}
+	}
+	return &result
+}
+
+func fromExportsResult(result *language.ExportsResult) *Exports {
+	exports := Exports{
+		Exports: make([]Export, len(result.Exports)),
+		Errors:  fromErrors(result.Errors),
+	}
+	for i, entry := range result.Exports {
+		var symbols []ExportSymbol
+		for _, symbol := range entry.Symbols {
+			symbols = append(symbols, ExportSymbol{Original: symbol.Original, Alias: symbol.Alias})
+		}
+		exports.Exports[i] = Export{All: entry.All, Symbols: symbols, Path: entry.AbsPath}
+	}
+	return &exports
+}
@@ -0,0 +1,73 @@
+package plugin
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+// Serve answers the requests read from r with the provided language, writing the
+// responses to w, until r is closed. Plugins written in Go can implement the
+// language.Language interface and just call Serve(lang, os.Stdin, os.Stdout).
+func Serve(lang language.Language, r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	encoder := json.NewEncoder(w)
+	files := make(map[string]*language.FileInfo)
+
+	parseFile := func(path string) (*language.FileInfo, error) {
+		if file, ok := files[path]; ok {
+			return file, nil
+		}
+		file, err := lang.ParseFile(path)
+		if err != nil {
+			return nil, err
+		}
+		files[path] = file
+		return file, nil
+	}
+
+	for scanner.Scan() {
+		var request Request
+		if err := json.Unmarshal(scanner.Bytes(), &request); err != nil {
+			return fmt.Errorf("invalid request: %w", err)
+		}
+		response := Response{Id: request.Id}
+		file, err := parseFile(request.Path)
+		if err == nil {
+			switch request.Method {
+			case MethodParseFile:
+				response.File = &File{
+					RelPath:       file.RelPath,
+					Package:       file.Package,
+					Loc:           file.Loc,
+					Size:          file.Size,
+					Types:         file.Types,
+					AbstractTypes: file.AbstractTypes,
+				}
+			case MethodParseImports:
+				var imports *language.ImportsResult
+				if imports, err = lang.ParseImports(file); err == nil {
+					response.Imports = fromImportsResult(imports)
+				}
+			case MethodParseExports:
+				var exports *language.ExportsResult
+				if exports, err = lang.ParseExports(file); err == nil {
+					response.Exports = fromExportsResult(exports)
+				}
+			default:
+				err = fmt.Errorf("unknown method %q", request.Method)
+			}
+		}
+		if err != nil {
+			response.Error = err.Error()
+		}
+		if err = encoder.Encode(response); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
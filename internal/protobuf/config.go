@@ -0,0 +1,6 @@
+package protobuf
+
+type Config struct {
+	// ProtoRoots are the dirs against which imports are resolved, like protoc's -I flag.
+	ProtoRoots []string `yaml:"protoRoots"`
+}
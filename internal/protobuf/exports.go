@@ -0,0 +1,39 @@
+package protobuf
+
+import (
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/protobuf/protobuf_grammar"
+)
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	exports := make([]language.ExportEntry, 0)
+
+	content := file.Content.(*protobuf_grammar.File)
+	roots := l.protoRoots(file.AbsPath, content.Package())
+	var symbols []language.ExportSymbol
+	for _, stmt := range content.Statements {
+		switch {
+		case stmt.Import != nil && stmt.Import.Modifier == "public":
+			// Public imports make the imported definitions available to the
+			// importers of this file.
+			if absPath := resolve(stmt.Import.Path, roots); absPath != "" {
+				exports = append(exports, language.ExportEntry{
+					All:     true,
+					AbsPath: absPath,
+				})
+			}
+		case stmt.Declaration != nil:
+			symbols = append(symbols, language.ExportSymbol{Original: stmt.Declaration.Name})
+		}
+	}
+	if len(symbols) > 0 {
+		exports = append(exports, language.ExportEntry{
+			Symbols: symbols,
+			AbsPath: file.AbsPath,
+		})
+	}
+
+	return &language.ExportsResult{
+		Exports: exports,
+	}, nil
+}
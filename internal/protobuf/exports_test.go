@@ -0,0 +1,56 @@
+package protobuf
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func TestLanguage_ParseExports(t *testing.T) {
+	acme, _ := filepath.Abs(filepath.Join(testFolder, "proto", "acme"))
+
+	tests := []struct {
+		Name     string
+		File     string
+		Expected []language.ExportEntry
+	}{
+		{
+			Name: "messages and services",
+			File: filepath.Join(acme, "public", "v1", "users.proto"),
+			Expected: []language.ExportEntry{{
+				Symbols: []language.ExportSymbol{
+					{Original: "User"},
+					{Original: "Users"},
+					{Original: "GetUserRequest"},
+				},
+				AbsPath: filepath.Join(acme, "public", "v1", "users.proto"),
+			}},
+		},
+		{
+			Name: "public imports",
+			File: filepath.Join(acme, "common", "v1", "money.proto"),
+			Expected: []language.ExportEntry{
+				{All: true, AbsPath: filepath.Join(acme, "common", "v1", "currency.proto")},
+				{Symbols: []language.ExportSymbol{{Original: "Money"}}, AbsPath: filepath.Join(acme, "common", "v1", "money.proto")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeProtobufLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			exports, err := lang.ParseExports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, exports.Exports)
+		})
+	}
+}
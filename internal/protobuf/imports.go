@@ -0,0 +1,25 @@
+package protobuf
+
+import (
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/protobuf/protobuf_grammar"
+)
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	imports := make([]language.ImportEntry, 0)
+
+	content := file.Content.(*protobuf_grammar.File)
+	roots := l.protoRoots(file.AbsPath, content.Package())
+	for _, stmt := range content.Statements {
+		if stmt.Import == nil {
+			continue
+		}
+		if absPath := resolve(stmt.Import.Path, roots); absPath != "" {
+			entry := language.EmptyImport(absPath)
+			entry.Line, entry.Column = stmt.Import.Pos.Line, stmt.Import.Pos.Column
+			imports = append(imports, entry)
+		}
+	}
+
+	return &language.ImportsResult{Imports: imports}, nil
+}
@@ -0,0 +1,75 @@
+package protobuf
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".protobuf_test"
+
+func TestLanguage_ParseImports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+	acme := filepath.Join(absTestFolder, "proto", "acme")
+
+	tests := []struct {
+		Name       string
+		File       string
+		ProtoRoots []string
+		Expected   []language.ImportEntry
+	}{
+		{
+			Name: "buf workspace directories",
+			File: filepath.Join(acme, "public", "v1", "users.proto"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(acme, "common", "v1", "money.proto")), 5, 1),
+				at(language.EmptyImport(filepath.Join(acme, "internal", "v1", "audit.proto")), 6, 1),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "vendor", "third", "party.proto")), 8, 1),
+			},
+		},
+		{
+			Name: "public imports",
+			File: filepath.Join(acme, "common", "v1", "money.proto"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(acme, "common", "v1", "currency.proto")), 5, 1),
+			},
+		},
+		{
+			Name:       "configured proto roots",
+			File:       filepath.Join(absTestFolder, "other", "service.proto"),
+			ProtoRoots: []string{filepath.Join(testFolder, "other", "include")},
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "other", "include", "shared.proto")), 5, 1),
+			},
+		},
+		{
+			Name:     "without proto roots",
+			File:     filepath.Join(absTestFolder, "other", "service.proto"),
+			Expected: []language.ImportEntry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeProtobufLanguage(&Config{ProtoRoots: tt.ProtoRoots})
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			imports, err := lang.ParseImports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, imports.Imports)
+			a.Nil(imports.Errors)
+		})
+	}
+}
+
+func at(entry language.ImportEntry, line, column int) language.ImportEntry {
+	entry.Line, entry.Column = line, column
+	return entry
+}
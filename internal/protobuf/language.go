@@ -0,0 +1,55 @@
+package protobuf
+
+import (
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/protobuf/protobuf_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var Extensions = []string{
+	"proto",
+}
+
+type Language struct {
+	cfg *Config
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+
+func MakeProtobufLanguage(cfg *Config) (language.Language, error) {
+	lang := Language{
+		cfg: cfg,
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+	return &lang, nil
+}
+
+var parseProtobufFile, evictProtobufFile = utils.EvictableCached1In1OutErr(protobuf_grammar.Parse)
+
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	parsed, err := parseProtobufFile(id)
+	if err != nil {
+		return nil, err
+	}
+	file := *parsed
+	content := file.Content.(*protobuf_grammar.File)
+	if pkg := content.Package(); pkg != nil {
+		file.Package = pkg.String()
+	}
+	if projectRoot := findProjectRoot(filepath.Dir(id)); projectRoot != nil {
+		file.RelPath, _ = filepath.Rel(projectRoot.AbsDir, id)
+	} else {
+		file.RelPath, _ = filepath.Rel(packageRoot(id, content.Package()), id)
+	}
+	return &file, nil
+}
+
+// Invalidate drops the provided files.
+func (l *Language) Invalidate(absPaths ...string) {
+	evictProtobufFile(utils.IsAnyOf(absPaths))
+}
@@ -0,0 +1,37 @@
+package protobuf
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguage_ParseFile(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Path            string
+		ExpectedRelPath string
+		ExpectedPackage string
+	}{
+		{
+			Name:            "file in a buf workspace",
+			Path:            filepath.Join(testFolder, "proto", "acme", "public", "v1", "users.proto"),
+			ExpectedRelPath: "proto/acme/public/v1/users.proto",
+			ExpectedPackage: "acme.public.v1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeProtobufLanguage(nil)
+			a.NoError(err)
+			absPath, _ := filepath.Abs(tt.Path)
+			file, err := lang.ParseFile(absPath)
+			a.NoError(err)
+			a.Equal(tt.ExpectedPackage, file.Package)
+			a.Equal(tt.ExpectedRelPath, file.RelPath)
+		})
+	}
+}
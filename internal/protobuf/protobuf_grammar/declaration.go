@@ -0,0 +1,8 @@
+//nolint:govet
+package protobuf_grammar
+
+// Declaration is a top level message or service declaration.
+type Declaration struct {
+	Kind string `@("message" | "service")`
+	Name string `@Ident`
+}
@@ -0,0 +1,76 @@
+//nolint:govet
+package protobuf_grammar
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+type Statement struct {
+	Package     *Package     `  @@`
+	Import      *Import      `| @@`
+	Declaration *Declaration `| @@`
+	// Block swallows anything between braces, so that only top level
+	// declarations are matched as statements.
+	Block *Block `| @@`
+}
+
+type Block struct {
+	Blocks []*Block `"{" (@@ | ANY | Punct | Ident | String)* "}"`
+}
+
+type File struct {
+	Statements []*Statement `(@@ | ANY | Punct | Ident | String | Brace)*`
+}
+
+var (
+	lex = lexer.MustSimple(
+		[]lexer.SimpleRule{
+			{"Comment", `//.*|/\*(.|\n)*?\*/`},
+			{"String", `"(?:\\.|[^"\\])*"` + "|" + `'(?:\\.|[^'\\])*'`},
+			{"Ident", `[_a-zA-Z][_a-zA-Z0-9]*`},
+			{"Brace", `[{}]`},
+			{"Punct", `[.;,=()<>\[\]]`},
+			{"Whitespace", `\s+`},
+			{"ANY", `.`},
+		},
+	)
+	parser = participle.MustBuild[File](
+		participle.Lexer(lex),
+		participle.Elide("Whitespace", "Comment"),
+		utils.UnquoteSafe("String"),
+		participle.UseLookahead(1024),
+	)
+)
+
+// Package returns the package declared in the file, or nil if there is none.
+func (f *File) Package() *Package {
+	for _, stmt := range f.Statements {
+		if stmt.Package != nil {
+			return stmt.Package
+		}
+	}
+	return nil
+}
+
+func Parse(filePath string) (*language.FileInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseBytes(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	return &language.FileInfo{
+		Content: file,
+		Loc:     bytes.Count(content, []byte("\n")),
+		Size:    len(content),
+		AbsPath: filePath,
+	}, nil
+}
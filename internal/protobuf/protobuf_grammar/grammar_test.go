@@ -0,0 +1,70 @@
+package protobuf_grammar
+
+import (
+	"testing"
+
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		Name                 string
+		ExpectedPackage      string
+		ExpectedImports      []Import
+		ExpectedDeclarations []string
+	}{
+		{
+			Name:            "syntax = \"proto3\";\npackage acme.api.v1;",
+			ExpectedPackage: "acme.api.v1",
+		},
+		{
+			Name: "import \"google/protobuf/timestamp.proto\";\nimport public 'common/money.proto';\nimport weak \"old.proto\";",
+			ExpectedImports: []Import{
+				{Path: "google/protobuf/timestamp.proto"},
+				{Modifier: "public", Path: "common/money.proto"},
+				{Modifier: "weak", Path: "old.proto"},
+			},
+		},
+		{
+			Name:                 "message User {\n  message Address { string city = 1; }\n  enum Role { ADMIN = 0; }\n  string name = 1;\n}\nenum Status { OK = 0; }",
+			ExpectedDeclarations: []string{"message User"},
+		},
+		{
+			Name:                 "service Users {\n  rpc Get(GetRequest) returns (User) { option (google.api.http) = { get: \"/v1/{name}\" }; }\n}",
+			ExpectedDeclarations: []string{"service Users"},
+		},
+		{
+			Name:                 "// message Commented {}\n/* service Also {} */\noption go_package = \"acme/api\";\nmessage A {}",
+			ExpectedDeclarations: []string{"message A"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			parsed, err := parser.ParseBytes("", []byte(tt.Name))
+			a.NoError(err)
+
+			var pkg string
+			if p := parsed.Package(); p != nil {
+				pkg = p.String()
+			}
+			var imports []Import
+			var declarations []string
+			for _, stmt := range parsed.Statements {
+				switch {
+				case stmt.Import != nil:
+					imported := *stmt.Import
+					imported.Pos = lexer.Position{}
+					imports = append(imports, imported)
+				case stmt.Declaration != nil:
+					declarations = append(declarations, stmt.Declaration.Kind+" "+stmt.Declaration.Name)
+				}
+			}
+			a.Equal(tt.ExpectedPackage, pkg)
+			a.Equal(tt.ExpectedImports, imports)
+			a.Equal(tt.ExpectedDeclarations, declarations)
+		})
+	}
+}
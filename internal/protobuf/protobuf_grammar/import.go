@@ -0,0 +1,12 @@
+//nolint:govet
+package protobuf_grammar
+
+import "github.com/alecthomas/participle/v2/lexer"
+
+type Import struct {
+	Pos lexer.Position
+	// Modifier is either "public", for imports that are re-exported to the
+	// importers of this file, "weak", or empty.
+	Modifier string `"import" @("public" | "weak")?`
+	Path     string `@String ";"`
+}
@@ -0,0 +1,99 @@
+package protobuf
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/gabotechs/dep-tree/internal/protobuf/protobuf_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var findProjectRoot = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	"buf.work.yaml",
+	"buf.yaml",
+	".git/index",
+})
+
+var findBufWorkspace = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	"buf.work.yaml",
+})
+
+var findBufModule = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	"buf.yaml",
+})
+
+type bufWork struct {
+	Directories []string `yaml:"directories"`
+}
+
+// _readBufWorkDirs returns the absolute paths of the modules declared in a
+// buf.work.yaml file, each one of them is a proto root.
+func _readBufWorkDirs(dir string) ([]string, error) {
+	content, err := os.ReadFile(filepath.Join(dir, "buf.work.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	var work bufWork
+	err = yaml.Unmarshal(content, &work)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, len(work.Directories))
+	for i, d := range work.Directories {
+		result[i] = filepath.Join(dir, d)
+	}
+	return result, nil
+}
+
+var readBufWorkDirs = utils.Cached1In1OutErr(_readBufWorkDirs)
+
+// packageRoot infers the proto root of a file based on its package declaration,
+// e.g. /project/proto/acme/api/v1/users.proto declaring `package acme.api.v1;`
+// has /project/proto as its proto root.
+func packageRoot(absPath string, pkg *protobuf_grammar.Package) string {
+	dir := filepath.Dir(absPath)
+	if pkg == nil {
+		return dir
+	}
+	pkgDir := filepath.Join(pkg.Path...)
+	if strings.HasSuffix(dir, string(os.PathSeparator)+pkgDir) {
+		return strings.TrimSuffix(dir, string(os.PathSeparator)+pkgDir)
+	}
+	return dir
+}
+
+// protoRoots returns the dirs against which imports in the provided file are
+// resolved, in order of preference: the configured ones, the ones declared in a
+// buf workspace or module, and the one inferred from the file's package.
+func (l *Language) protoRoots(absPath string, pkg *protobuf_grammar.Package) []string {
+	var roots []string
+	for _, root := range l.cfg.ProtoRoots {
+		if abs, err := filepath.Abs(root); err == nil {
+			roots = append(roots, abs)
+		}
+	}
+	if workspace := findBufWorkspace(filepath.Dir(absPath)); workspace != nil {
+		if dirs, err := readBufWorkDirs(workspace.AbsDir); err == nil {
+			roots = append(roots, dirs...)
+		}
+	} else if module := findBufModule(filepath.Dir(absPath)); module != nil {
+		roots = append(roots, module.AbsDir)
+	}
+	roots = append(roots, packageRoot(absPath, pkg))
+	return roots
+}
+
+// resolve resolves an imported proto file against the proto roots. Imports that
+// are not found in any root, like the well known types, return an empty string.
+func resolve(path string, roots []string) string {
+	for _, root := range roots {
+		candidate := filepath.Join(root, filepath.FromSlash(path))
+		if utils.FileExists(candidate) {
+			return candidate
+		}
+	}
+	return ""
+}
@@ -134,18 +134,23 @@ func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResul
 
 	content := file.Content.(*python_grammar.File)
 	for _, stmt := range content.Statements {
+		var newImports []language.ImportEntry
 		switch {
 		case stmt == nil:
 			// Is this even possible?
 		case stmt.Import != nil:
-			imports = append(imports, l.handleImport(stmt.Import, filepath.Dir(file.AbsPath))...)
+			newImports = l.handleImport(stmt.Import, filepath.Dir(file.AbsPath))
 		case stmt.FromImport != nil:
-			newImports, err := l.handleFromImport(stmt.FromImport, filepath.Dir(file.AbsPath))
-			imports = append(imports, newImports...)
+			var err error
+			newImports, err = l.handleFromImport(stmt.FromImport, filepath.Dir(file.AbsPath))
 			if err != nil {
 				errors = append(errors, err)
 			}
 		}
+		for _, entry := range newImports {
+			entry.Line, entry.Column = stmt.Pos.Line, stmt.Pos.Column
+			imports = append(imports, entry)
+		}
 	}
 	return &language.ImportsResult{Imports: imports, Errors: errors}, nil
 }
@@ -28,16 +28,16 @@ func TestLanguage_ParseImports(t *testing.T) {
 			File:       "main.py",
 			Entrypoint: "main.py",
 			Expected: []language.ImportEntry{
-				language.EmptyImport(filepath.Join(importsTestFolder, "src", "foo.py")),
-				language.EmptyImport(filepath.Join(importsTestFolder, "src", "main.py")),
-				language.EmptyImport(filepath.Join(importsTestFolder, "src", "main.py")),
-				language.EmptyImport(filepath.Join(importsTestFolder, "src", "module", "__init__.py")),
-				language.SymbolsImport([]string{"main"}, filepath.Join(importsTestFolder, "src", "main.py")),
-				language.EmptyImport(filepath.Join(importsTestFolder, "src", "main.py")),
-				language.SymbolsImport([]string{"main"}, filepath.Join(importsTestFolder, "src", "main.py")),
-				language.AllImport(filepath.Join(importsTestFolder, "src", "module", "__init__.py")),
-				language.EmptyImport(filepath.Join(importsTestFolder, "src", "module", "module.py")),
-				language.SymbolsImport([]string{"bar"}, filepath.Join(importsTestFolder, "src", "module", "__init__.py")),
+				at(language.EmptyImport(filepath.Join(importsTestFolder, "src", "foo.py")), 1, 1),
+				at(language.EmptyImport(filepath.Join(importsTestFolder, "src", "main.py")), 1, 1),
+				at(language.EmptyImport(filepath.Join(importsTestFolder, "src", "main.py")), 2, 1),
+				at(language.EmptyImport(filepath.Join(importsTestFolder, "src", "module", "__init__.py")), 3, 1),
+				at(language.SymbolsImport([]string{"main"}, filepath.Join(importsTestFolder, "src", "main.py")), 6, 1),
+				at(language.EmptyImport(filepath.Join(importsTestFolder, "src", "main.py")), 9, 1),
+				at(language.SymbolsImport([]string{"main"}, filepath.Join(importsTestFolder, "src", "main.py")), 11, 1),
+				at(language.AllImport(filepath.Join(importsTestFolder, "src", "module", "__init__.py")), 14, 1),
+				at(language.EmptyImport(filepath.Join(importsTestFolder, "src", "module", "module.py")), 15, 1),
+				at(language.SymbolsImport([]string{"bar"}, filepath.Join(importsTestFolder, "src", "module", "__init__.py")), 16, 1),
 			},
 			ExpectedErrors: []string{
 				"cannot import file src.py from directory",
@@ -50,16 +50,16 @@ func TestLanguage_ParseImports(t *testing.T) {
 			Entrypoint:                "main.py",
 			ExcludeConditionalImports: true,
 			Expected: []language.ImportEntry{
-				language.EmptyImport(filepath.Join(importsTestFolder, "src", "foo.py")),
-				language.EmptyImport(filepath.Join(importsTestFolder, "src", "main.py")),
-				language.EmptyImport(filepath.Join(importsTestFolder, "src", "main.py")),
-				language.EmptyImport(filepath.Join(importsTestFolder, "src", "module", "__init__.py")),
+				at(language.EmptyImport(filepath.Join(importsTestFolder, "src", "foo.py")), 1, 1),
+				at(language.EmptyImport(filepath.Join(importsTestFolder, "src", "main.py")), 1, 1),
+				at(language.EmptyImport(filepath.Join(importsTestFolder, "src", "main.py")), 2, 1),
+				at(language.EmptyImport(filepath.Join(importsTestFolder, "src", "module", "__init__.py")), 3, 1),
 				// language.SymbolsImport([]string{"main"}, filepath.Join(importsTestFolder, "src", "main.py")),
 				// language.EmptyImport(filepath.Join(importsTestFolder, "src", "main.py")),
-				language.SymbolsImport([]string{"main"}, filepath.Join(importsTestFolder, "src", "main.py")),
-				language.AllImport(filepath.Join(importsTestFolder, "src", "module", "__init__.py")),
-				language.EmptyImport(filepath.Join(importsTestFolder, "src", "module", "module.py")),
-				language.SymbolsImport([]string{"bar"}, filepath.Join(importsTestFolder, "src", "module", "__init__.py")),
+				at(language.SymbolsImport([]string{"main"}, filepath.Join(importsTestFolder, "src", "main.py")), 6, 1),
+				at(language.AllImport(filepath.Join(importsTestFolder, "src", "module", "__init__.py")), 14, 1),
+				at(language.EmptyImport(filepath.Join(importsTestFolder, "src", "module", "module.py")), 15, 1),
+				at(language.SymbolsImport([]string{"bar"}, filepath.Join(importsTestFolder, "src", "module", "__init__.py")), 16, 1),
 			},
 			ExpectedErrors: []string{
 				"cannot import file src.py from directory",
@@ -138,3 +138,8 @@ func TestLanguage_ParseImports_Errors(t *testing.T) {
 		})
 	}
 }
+
+func at(entry language.ImportEntry, line, column int) language.ImportEntry {
+	entry.Line, entry.Column = line, column
+	return entry
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gabotechs/dep-tree/internal/language"
 	"github.com/gabotechs/dep-tree/internal/python/python_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
 )
 
 var Extensions = []string{
@@ -20,6 +21,7 @@ type Language struct {
 }
 
 var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
 
 func MakePythonLanguage(cfg *Config) (language.Language, error) {
 	lang := Language{
@@ -49,3 +51,9 @@ func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
 	// NOTE: Python has no sense of packages
 	return file, nil
 }
+
+// Invalidate drops the listing of the dirs where the provided files live, as they
+// might have been created or removed.
+func (l *Language) Invalidate(absPaths ...string) {
+	evictPythonFilesInDir(utils.IsDirOfAnyOf(absPaths))
+}
@@ -0,0 +1,8 @@
+module Admin
+  class UsersController < ApplicationController
+    def index
+      @users = User.all
+      @role = Role::DEFAULT
+    end
+  end
+end
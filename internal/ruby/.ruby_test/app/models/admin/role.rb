@@ -0,0 +1,5 @@
+module Admin
+  class Role
+    DEFAULT = "admin"
+  end
+end
@@ -0,0 +1,3 @@
+module Trackable
+  extend ActiveSupport::Concern
+end
@@ -0,0 +1,11 @@
+require "greeter"
+
+class User < ApplicationRecord
+  include Trackable
+
+  has_many :posts
+
+  def greet
+    Greeter.new.call(Admin::Role::DEFAULT)
+  end
+end
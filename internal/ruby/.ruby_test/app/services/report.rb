@@ -0,0 +1,5 @@
+require_relative "../../lib/greeter/version"
+
+class Report
+  def version = Greeter::VERSION
+end
@@ -0,0 +1,8 @@
+require "greeter/version"
+require "json"
+
+class Greeter
+  def call(name)
+    "Hello #{name}"
+  end
+end
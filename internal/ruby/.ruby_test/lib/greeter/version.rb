@@ -0,0 +1,3 @@
+class Greeter
+  VERSION = "1.0.0"
+end
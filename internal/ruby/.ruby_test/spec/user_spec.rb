@@ -0,0 +1,5 @@
+require "spec_helper"
+
+RSpec.describe User do
+  it { expect(::User.new.greet).to eq("Hello admin") }
+end
@@ -0,0 +1,10 @@
+package ruby
+
+type Config struct {
+	// LoadPaths are the dirs against which `require` is resolved, like Ruby's $LOAD_PATH.
+	LoadPaths []string `yaml:"loadPaths"`
+	// Zeitwerk infers dependencies from constant references, the way Rails autoloads them.
+	Zeitwerk bool `yaml:"zeitwerk"`
+	// AutoloadPaths are additional dirs autoloaded in Zeitwerk mode, besides app/*.
+	AutoloadPaths []string `yaml:"autoloadPaths"`
+}
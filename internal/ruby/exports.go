@@ -0,0 +1,27 @@
+package ruby
+
+import (
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/ruby/ruby_grammar"
+)
+
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	exports := make([]language.ExportEntry, 0)
+
+	var symbols []language.ExportSymbol
+	for _, stmt := range file.Content.(*ruby_grammar.File).Statements {
+		if stmt.Definition != nil {
+			symbols = append(symbols, language.ExportSymbol{Original: stmt.Definition.Name.String()})
+		}
+	}
+	if len(symbols) > 0 {
+		exports = append(exports, language.ExportEntry{
+			Symbols: symbols,
+			AbsPath: file.AbsPath,
+		})
+	}
+
+	return &language.ExportsResult{
+		Exports: exports,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package ruby
+
+import (
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/ruby/ruby_grammar"
+)
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	imports := make([]language.ImportEntry, 0)
+
+	var roots []string
+	var scope []string
+	if l.cfg.Zeitwerk {
+		roots = l.autoloadPaths(file.AbsPath)
+		scope = nesting(file.AbsPath, roots)
+	}
+
+	seen := map[string]struct{}{file.AbsPath: {}}
+	add := func(absPath string, stmt *ruby_grammar.Statement) {
+		if _, ok := seen[absPath]; ok || absPath == "" {
+			return
+		}
+		seen[absPath] = struct{}{}
+		entry := language.EmptyImport(absPath)
+		entry.Line, entry.Column = stmt.Pos.Line, stmt.Pos.Column
+		imports = append(imports, entry)
+	}
+
+	for _, stmt := range file.Content.(*ruby_grammar.File).Statements {
+		switch {
+		case stmt.Require != nil:
+			add(l.resolveRequire(file.AbsPath, stmt.Require.Path, stmt.Require.Relative), stmt)
+		case stmt.Autoload != nil:
+			add(l.resolveRequire(file.AbsPath, stmt.Autoload.Path, false), stmt)
+		case stmt.Constant != nil && l.cfg.Zeitwerk:
+			add(resolveConstant(stmt.Constant, scope, roots), stmt)
+		}
+	}
+
+	return &language.ImportsResult{Imports: imports}, nil
+}
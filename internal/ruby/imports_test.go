@@ -0,0 +1,107 @@
+package ruby
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".ruby_test"
+
+func TestLanguage_ParseImports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+
+	tests := []struct {
+		Name     string
+		File     string
+		Zeitwerk bool
+		Expected []language.ImportEntry
+	}{
+		{
+			Name: "require",
+			File: filepath.Join("lib", "greeter.rb"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "lib", "greeter", "version.rb")), 1, 1),
+			},
+		},
+		{
+			Name: "require_relative",
+			File: filepath.Join("app", "services", "report.rb"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "lib", "greeter", "version.rb")), 1, 1),
+			},
+		},
+		{
+			Name: "without Zeitwerk constants are ignored",
+			File: filepath.Join("app", "models", "user.rb"),
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "lib", "greeter.rb")), 1, 1),
+			},
+		},
+		{
+			Name:     "Zeitwerk",
+			File:     filepath.Join("app", "models", "user.rb"),
+			Zeitwerk: true,
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "lib", "greeter.rb")), 1, 1),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "app", "models", "application_record.rb")), 3, 14),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "app", "models", "concerns", "trackable.rb")), 4, 11),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "app", "models", "admin", "role.rb")), 9, 22),
+			},
+		},
+		{
+			Name:     "Zeitwerk with nesting",
+			File:     filepath.Join("app", "controllers", "admin", "users_controller.rb"),
+			Zeitwerk: true,
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "app", "controllers", "application_controller.rb")), 2, 27),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "app", "models", "user.rb")), 4, 16),
+				at(language.EmptyImport(filepath.Join(absTestFolder, "app", "models", "admin", "role.rb")), 5, 15),
+			},
+		},
+		{
+			Name:     "Zeitwerk from outside the autoload paths",
+			File:     filepath.Join("spec", "user_spec.rb"),
+			Zeitwerk: true,
+			Expected: []language.ImportEntry{
+				at(language.EmptyImport(filepath.Join(absTestFolder, "app", "models", "user.rb")), 3, 16),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeRubyLanguage(&Config{Zeitwerk: tt.Zeitwerk})
+			a.NoError(err)
+
+			file, err := lang.ParseFile(filepath.Join(absTestFolder, tt.File))
+			a.NoError(err)
+
+			imports, err := lang.ParseImports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, imports.Imports)
+			a.Nil(imports.Errors)
+		})
+	}
+}
+
+func TestMakeRubyLanguage_DoesNotMutateConfig(t *testing.T) {
+	a := require.New(t)
+	t.Setenv("RUBYLIB", "/rubylib")
+	cfg := &Config{LoadPaths: []string{"lib"}}
+	for i := 0; i < 2; i++ {
+		lang, err := MakeRubyLanguage(cfg)
+		a.NoError(err)
+		a.Equal([]string{"lib", "/rubylib"}, lang.(*Language).extraLoadPaths)
+	}
+	a.Equal([]string{"lib"}, cfg.LoadPaths)
+}
+
+func at(entry language.ImportEntry, line, column int) language.ImportEntry {
+	entry.Line, entry.Column = line, column
+	return entry
+}
@@ -0,0 +1,67 @@
+package ruby
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/ruby/ruby_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var Extensions = []string{
+	"rb",
+	"rake",
+}
+
+type Language struct {
+	cfg *Config
+	// extraLoadPaths are the configured LoadPaths plus the ones in RUBYLIB.
+	extraLoadPaths []string
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+
+func MakeRubyLanguage(cfg *Config) (language.Language, error) {
+	lang := Language{
+		cfg: cfg,
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+
+	// Add anything present on the RUBYLIB, without touching the config, as it
+	// might be shared with other instances of the language.
+	lang.extraLoadPaths = slices.Clone(lang.cfg.LoadPaths)
+	rubyLib := os.Getenv("RUBYLIB")
+	if rubyLib != "" {
+		lang.extraLoadPaths = append(lang.extraLoadPaths, filepath.SplitList(rubyLib)...)
+	}
+	return &lang, nil
+}
+
+var findProjectRoot = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	"Gemfile",
+})
+
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	file, err := ruby_grammar.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	if projectRoot := findProjectRoot(filepath.Dir(id)); projectRoot != nil {
+		file.RelPath, _ = filepath.Rel(projectRoot.AbsDir, id)
+	}
+	return file, nil
+}
+
+// Invalidate drops the autoload root dirs of the projects where the provided files
+// live under app, as dirs there might have been created or removed.
+func (l *Language) Invalidate(absPaths ...string) {
+	containsAnyOf := utils.ContainsAnyOf(absPaths)
+	evictAutoloadPaths(func(projectRoot string) bool {
+		return containsAnyOf(filepath.Join(projectRoot, "app"))
+	})
+}
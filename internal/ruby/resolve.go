@@ -0,0 +1,57 @@
+package ruby
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// loadPaths returns the dirs against which `require` is resolved for the provided
+// file: the lib dir of the project, plus the ones configured by the user.
+func (l *Language) loadPaths(absPath string) []string {
+	var result []string
+	if projectRoot := findProjectRoot(filepath.Dir(absPath)); projectRoot != nil {
+		result = append(result, filepath.Join(projectRoot.AbsDir, "lib"))
+	}
+	for _, dir := range l.extraLoadPaths {
+		if abs, err := filepath.Abs(dir); err == nil {
+			result = append(result, abs)
+		}
+	}
+	return result
+}
+
+// resolveRubyFile returns the absolute path of the Ruby file referenced by path,
+// which might or might not have the .rb extension, or an empty string if it does
+// not exist.
+func resolveRubyFile(path string) string {
+	candidates := []string{path + ".rb"}
+	if strings.HasSuffix(path, ".rb") {
+		candidates = []string{path}
+	}
+	for _, candidate := range candidates {
+		if utils.FileExists(candidate) {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// resolveRequire resolves a `require` or `require_relative` path. Requires that do
+// not resolve to a file, like the ones of gems or of the standard library, return an
+// empty string.
+func (l *Language) resolveRequire(absPath string, path string, relative bool) string {
+	if relative {
+		return resolveRubyFile(filepath.Join(filepath.Dir(absPath), path))
+	}
+	if filepath.IsAbs(path) {
+		return resolveRubyFile(path)
+	}
+	for _, dir := range l.loadPaths(absPath) {
+		if resolved := resolveRubyFile(filepath.Join(dir, path)); resolved != "" {
+			return resolved
+		}
+	}
+	return ""
+}
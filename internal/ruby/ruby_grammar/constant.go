@@ -0,0 +1,21 @@
+//nolint:govet
+package ruby_grammar
+
+import "strings"
+
+// Constant is a reference to a constant, like Foo::Bar, or ::Foo for
+// explicitly referencing a top level constant.
+type Constant struct {
+	Absolute bool     `@Scope?`
+	Path     []string `@Const (Scope @Const)*`
+}
+
+func (c *Constant) String() string {
+	return strings.Join(c.Path, "::")
+}
+
+// Definition is a class or module definition, like `class Foo::Bar < Baz`.
+type Definition struct {
+	Kind string    `@("class" | "module")`
+	Name *Constant `@@`
+}
@@ -0,0 +1,64 @@
+//nolint:govet
+package ruby_grammar
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+type Statement struct {
+	Pos        lexer.Position
+	Require    *Require    `  @@`
+	Autoload   *Autoload   `| @@`
+	Definition *Definition `| @@`
+	Constant   *Constant   `| @@`
+}
+
+type File struct {
+	Statements []*Statement `(@@ | ANY | Punct | Scope | Symbol | Ident | Var | String)*`
+}
+
+var (
+	lex = lexer.MustSimple(
+		[]lexer.SimpleRule{
+			{"String", `"(?:\\.|[^"\\])*"` + "|" + `'(?:\\.|[^'\\])*'`},
+			{"Comment", `#.*|=begin(.|\n)*?\n=end`},
+			{"Scope", `::`},
+			{"Symbol", `:[_a-zA-Z][_a-zA-Z0-9]*[?!=]?`},
+			{"Var", `@@?[_a-zA-Z][_a-zA-Z0-9]*|\$[_a-zA-Z0-9]+|\$.`},
+			{"Const", `[A-Z][_a-zA-Z0-9]*`},
+			{"Ident", `[_a-z][_a-zA-Z0-9]*[?!]?`},
+			{"Punct", `[.,;()\[\]{}=<>+\-*/%&|^~!?:]`},
+			{"Whitespace", `\s+`},
+			{"ANY", `.`},
+		},
+	)
+	parser = participle.MustBuild[File](
+		participle.Lexer(lex),
+		participle.Elide("Whitespace", "Comment"),
+		utils.UnquoteSafe("String"),
+		participle.UseLookahead(1024),
+	)
+)
+
+func Parse(filePath string) (*language.FileInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseBytes(filePath, content)
+	if err != nil {
+		return nil, err
+	}
+	return &language.FileInfo{
+		Content: file,
+		Loc:     bytes.Count(content, []byte("\n")),
+		Size:    len(content),
+		AbsPath: filePath,
+	}, nil
+}
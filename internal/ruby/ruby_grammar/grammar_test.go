@@ -0,0 +1,73 @@
+package ruby_grammar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		Name                string
+		ExpectedRequires    []Require
+		ExpectedAutoloads   []string
+		ExpectedDefinitions []string
+		ExpectedConstants   []string
+	}{
+		{
+			Name:             "require 'foo'\nrequire_relative \"../bar\"\nrequire('baz/qux')",
+			ExpectedRequires: []Require{{Path: "foo"}, {Relative: true, Path: "../bar"}, {Path: "baz/qux"}},
+		},
+		{
+			Name:                "module Foo\n  autoload :Bar, 'foo/bar'\nend",
+			ExpectedAutoloads:   []string{"foo/bar"},
+			ExpectedDefinitions: []string{"module Foo"},
+		},
+		{
+			Name:                "class Admin::UsersController < ApplicationController\n  def index\n    @users = ::User.where(role: Role::ADMIN)\n  end\nend",
+			ExpectedDefinitions: []string{"class Admin::UsersController"},
+			ExpectedConstants:   []string{"ApplicationController", "::User", "Role::ADMIN"},
+		},
+		{
+			Name:              "class << self\n  def call = Foo::bar($LOAD_PATH)\nend",
+			ExpectedConstants: []string{"Foo"},
+		},
+		{
+			Name:              "# require 'foo'\nputs \"Foo::Bar #{x}\"\n=begin\nBaz\n=end\nQux",
+			ExpectedConstants: []string{"Qux"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			parsed, err := parser.ParseBytes("", []byte(tt.Name))
+			a.NoError(err)
+
+			var requires []Require
+			var autoloads []string
+			var definitions []string
+			var constants []string
+			for _, stmt := range parsed.Statements {
+				switch {
+				case stmt.Require != nil:
+					requires = append(requires, *stmt.Require)
+				case stmt.Autoload != nil:
+					autoloads = append(autoloads, stmt.Autoload.Path)
+				case stmt.Definition != nil:
+					definitions = append(definitions, stmt.Definition.Kind+" "+stmt.Definition.Name.String())
+				case stmt.Constant != nil:
+					name := stmt.Constant.String()
+					if stmt.Constant.Absolute {
+						name = "::" + name
+					}
+					constants = append(constants, name)
+				}
+			}
+			a.Equal(tt.ExpectedRequires, requires)
+			a.Equal(tt.ExpectedAutoloads, autoloads)
+			a.Equal(tt.ExpectedDefinitions, definitions)
+			a.Equal(tt.ExpectedConstants, constants)
+		})
+	}
+}
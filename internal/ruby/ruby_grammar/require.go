@@ -0,0 +1,13 @@
+//nolint:govet
+package ruby_grammar
+
+type Require struct {
+	Relative bool   `(@"require_relative" | "require")`
+	Path     string `( "(" @String ")" | @String )`
+}
+
+// Autoload registers a file to be required the first time a constant is
+// referenced, like `autoload :Foo, "foo"`.
+type Autoload struct {
+	Path string `"autoload" "("? Symbol "," @String ")"?`
+}
@@ -0,0 +1,118 @@
+package ruby
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/gabotechs/dep-tree/internal/ruby/ruby_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// underscore converts a constant name to the file name Zeitwerk expects it to be
+// declared in, e.g. UsersController -> users_controller and HTMLParser -> html_parser.
+func underscore(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				sb.WriteRune('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
+
+// _autoloadPaths returns the autoload root dirs of a Rails project: every dir
+// under app, and their concerns dirs.
+func _autoloadPaths(projectRoot string) []string {
+	var result []string
+	entries, _ := os.ReadDir(filepath.Join(projectRoot, "app"))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(projectRoot, "app", entry.Name())
+		result = append(result, dir)
+		if utils.DirExists(filepath.Join(dir, "concerns")) {
+			result = append(result, filepath.Join(dir, "concerns"))
+		}
+	}
+	return result
+}
+
+var autoloadPaths, evictAutoloadPaths = utils.EvictableCached1In1Out(_autoloadPaths)
+
+func (l *Language) autoloadPaths(absPath string) []string {
+	var result []string
+	if projectRoot := findProjectRoot(filepath.Dir(absPath)); projectRoot != nil {
+		result = append(result, autoloadPaths(projectRoot.AbsDir)...)
+	}
+	for _, dir := range l.cfg.AutoloadPaths {
+		if abs, err := filepath.Abs(dir); err == nil {
+			result = append(result, abs)
+		}
+	}
+	return result
+}
+
+// nesting returns the lexical scope in which constants are looked up from a file,
+// inferred from its path relative to the autoload root that contains it, e.g.
+// app/controllers/admin/users_controller.rb -> [admin, users_controller].
+func nesting(absPath string, roots []string) []string {
+	var best string
+	for _, root := range roots {
+		if strings.HasPrefix(absPath, root+string(os.PathSeparator)) && len(root) > len(best) {
+			best = root
+		}
+	}
+	if best == "" {
+		return nil
+	}
+	rel, _ := filepath.Rel(best, strings.TrimSuffix(absPath, ".rb"))
+	return strings.Split(rel, string(os.PathSeparator))
+}
+
+// resolveConstant resolves a constant reference to the file that declares it.
+// Like Ruby does, the first segment of the constant is looked up in the lexical
+// scope, from the innermost to the outermost, and the remaining segments resolve
+// to the deepest file that exists, as Foo::Bar::BAZ is declared in foo/bar.rb.
+func resolveConstant(constant *ruby_grammar.Constant, scope []string, roots []string) string {
+	path := make([]string, len(constant.Path))
+	for i, segment := range constant.Path {
+		path[i] = underscore(segment)
+	}
+	if constant.Absolute {
+		scope = nil
+	}
+
+	for i := len(scope); i >= 0; i-- {
+		prefix := scope[:i]
+		first := filepath.Join(append(append([]string{}, prefix...), path[0])...)
+		defined := false
+		for _, root := range roots {
+			if utils.FileExists(filepath.Join(root, first)+".rb") || utils.DirExists(filepath.Join(root, first)) {
+				defined = true
+				break
+			}
+		}
+		if !defined {
+			continue
+		}
+		for j := len(path); j > 0; j-- {
+			candidate := filepath.Join(append(append([]string{}, prefix...), path[:j]...)...) + ".rb"
+			for _, root := range roots {
+				if utils.FileExists(filepath.Join(root, candidate)) {
+					return filepath.Join(root, candidate)
+				}
+			}
+		}
+		return ""
+	}
+	return ""
+}
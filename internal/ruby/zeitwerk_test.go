@@ -0,0 +1,28 @@
+package ruby
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnderscore(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Expected string
+	}{
+		{Name: "User", Expected: "user"},
+		{Name: "UsersController", Expected: "users_controller"},
+		{Name: "HTMLParser", Expected: "html_parser"},
+		{Name: "OAuth2Client", Expected: "o_auth2_client"},
+		{Name: "DEFAULT", Expected: "default"},
+		{Name: "V1", Expected: "v1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			a.Equal(tt.Expected, underscore(tt.Name))
+		})
+	}
+}
@@ -29,11 +29,15 @@ func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResul
 					imports = append(imports, language.ImportEntry{
 						All:     use.All,
 						AbsPath: id,
+						Line:    stmt.Pos.Line,
+						Column:  stmt.Pos.Column,
 					})
 				} else {
 					imports = append(imports, language.ImportEntry{
 						Symbols: []string{string(use.Name.Original)},
 						AbsPath: id,
+						Line:    stmt.Pos.Line,
+						Column:  stmt.Pos.Column,
 					})
 				}
 			}
@@ -56,6 +60,8 @@ func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResul
 				All:     true,
 				Symbols: names,
 				AbsPath: modPath,
+				Line:    stmt.Pos.Line,
+				Column:  stmt.Pos.Column,
 			})
 		}
 	}
@@ -24,50 +24,72 @@ func TestLanguage_ParseImports(t *testing.T) {
 					All:     true,
 					Symbols: []string{"sum"},
 					AbsPath: filepath.Join(absTestFolder, "src", "sum.rs"),
+					Line:    1,
+					Column:  1,
 				},
 				{
 					All:     true,
 					Symbols: []string{"div"},
 					AbsPath: filepath.Join(absTestFolder, "src", "div", "mod.rs"),
+					Line:    2,
+					Column:  1,
 				},
 				{
 					All:     true,
 					Symbols: []string{"avg"},
 					AbsPath: filepath.Join(absTestFolder, "src", "avg.rs"),
+					Line:    3,
+					Column:  1,
 				},
 				{
 					All:     true,
 					Symbols: []string{"abs"},
 					AbsPath: filepath.Join(absTestFolder, "src", "abs.rs"),
+					Line:    4,
+					Column:  1,
 				},
 				{
 					All:     true,
 					Symbols: []string{"avg_2"},
 					AbsPath: filepath.Join(absTestFolder, "src", "avg_2.rs"),
+					Line:    5,
+					Column:  1,
 				},
 				{
 					Symbols: []string{"abs"},
 					AbsPath: filepath.Join(absTestFolder, "src", "abs", "abs.rs"),
+					Line:    7,
+					Column:  1,
 				},
 				{
 					Symbols: []string{"div"},
 					AbsPath: filepath.Join(absTestFolder, "src", "div", "mod.rs"),
+					Line:    8,
+					Column:  1,
 				},
 				{
 					Symbols: []string{"avg"},
 					AbsPath: filepath.Join(absTestFolder, "src", "avg_2.rs"),
+					Line:    9,
+					Column:  1,
 				},
 				{
 					Symbols: []string{"sum"},
 					AbsPath: filepath.Join(absTestFolder, "src", "lib.rs"),
+					Line:    10,
+					Column:  1,
 				},
 				{
 					All:     true,
 					AbsPath: filepath.Join(absTestFolder, "src", "sum.rs"),
+					Line:    11,
+					Column:  1,
 				},
 				{
 					Symbols: []string{"run"},
 					AbsPath: filepath.Join(absTestFolder, "src", "lib.rs"),
+					Line:    23,
+					Column:  5,
 				},
 			},
 		},
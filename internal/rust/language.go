@@ -4,6 +4,7 @@ import (
 	"path/filepath"
 
 	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
 )
 
 var Extensions = []string{
@@ -13,21 +14,30 @@ var Extensions = []string{
 type Language struct{}
 
 var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
 
 func MakeRustLanguage(_ *Config) (language.Language, error) {
 	return &Language{}, nil
 }
 
 func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
-	file, err := CachedRustFile(id)
+	parsed, err := CachedRustFile(id)
 	if err != nil {
 		return nil, err
 	}
+	file := *parsed
 	cargoToml, err := findClosestCargoToml(filepath.Dir(id))
 	if err != nil {
-		return file, nil
+		return &file, nil
 	}
 	file.Package = cargoToml.PackageDefinition.Name
 	file.RelPath, _ = filepath.Rel(cargoToml.path, id)
-	return file, nil
+	return &file, nil
+}
+
+// Invalidate drops the provided files and the mod trees of the crates containing them.
+func (l *Language) Invalidate(absPaths ...string) {
+	evictRustFile(utils.IsAnyOf(absPaths))
+	contains := utils.ContainsAnyOf(absPaths)
+	evictModTree(func(mainPath string) bool { return contains(filepath.Dir(mainPath)) })
 }
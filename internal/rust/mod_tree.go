@@ -19,10 +19,10 @@ const self = "self"
 const crate = "crate"
 const super = "super"
 
-var CachedRustFile = utils.Cached1In1OutErr(rust_grammar.Parse)
+var CachedRustFile, evictRustFile = utils.EvictableCached1In1OutErr(rust_grammar.Parse)
 
 // MakeModTree builds the ModTree given the main library/executable file path (src/lib.rs or src/main.rs).
-var MakeModTree = utils.Cached1In1OutErr(func(mainPath string) (*ModTree, error) {
+var MakeModTree, evictModTree = utils.EvictableCached1In1OutErr(func(mainPath string) (*ModTree, error) {
 	// "crate" always refers to the root mod of the cargo workspace.
 	return makeModTree(mainPath, "crate", nil)
 })
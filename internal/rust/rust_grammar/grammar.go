@@ -11,6 +11,7 @@ import (
 )
 
 type Statement struct {
+	Pos lexer.Position
 	Mod *Mod `@@`
 	Use *Use `| @@`
 	Pub *Pub `| @@`
@@ -0,0 +1,6 @@
+locals {
+  prefix = "acme"
+  tags = {
+    Owner = local.prefix
+  }
+}
@@ -0,0 +1,19 @@
+module "vpc" {
+  source = "./modules/vpc"
+  cidr   = var.cidr
+}
+
+module "app" {
+  source     = "./modules/app"
+  subnet_ids = module.vpc.subnet_ids
+  name       = "${local.prefix}-app"
+}
+
+module "consul" {
+  source  = "hashicorp/consul/aws"
+  version = "0.1.0"
+}
+
+module "storage" {
+  source = "git::https://example.com/storage.git?ref=v1.2.0"
+}
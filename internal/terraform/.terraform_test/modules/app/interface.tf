@@ -0,0 +1,11 @@
+variable "subnet_ids" {
+  type = list(string)
+}
+
+variable "name" {
+  type = string
+}
+
+output "url" {
+  value = "http://${aws_instance.web[0].public_dns}"
+}
@@ -0,0 +1,17 @@
+resource "aws_instance" "web" {
+  count     = length(var.subnet_ids)
+  subnet_id = var.subnet_ids[count.index]
+
+  user_data = <<-EOT
+    #!/bin/bash
+    echo "starting ${var.name}"
+  EOT
+}
+
+module "broken" {
+  source = "../broken"
+}
+
+module "missing" {
+  source = "../missing"
+}
@@ -0,0 +1,8 @@
+resource "aws_vpc" "this" {
+  cidr_block = var.cidr
+}
+
+resource "aws_subnet" "private" {
+  count  = 2
+  vpc_id = aws_vpc.this.id
+}
@@ -0,0 +1,3 @@
+output "app_url" {
+  value = module.app.url
+}
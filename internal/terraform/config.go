@@ -0,0 +1,3 @@
+package terraform
+
+type Config struct{}
@@ -0,0 +1,26 @@
+package terraform
+
+import (
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+// ParseExports returns the outputs of modules, as they are the only thing that
+// other modules can reference from them.
+func (l *Language) ParseExports(file *language.FileInfo) (*language.ExportsResult, error) {
+	exports := make([]language.ExportEntry, 0)
+	module, ok := file.Content.(*Module)
+	if ok && len(module.Outputs) > 0 {
+		symbols := make([]language.ExportSymbol, len(module.Outputs))
+		for i, output := range module.Outputs {
+			symbols[i] = language.ExportSymbol{Original: output}
+		}
+		exports = append(exports, language.ExportEntry{
+			Symbols: symbols,
+			AbsPath: module.AbsDir,
+		})
+	}
+
+	return &language.ExportsResult{
+		Exports: exports,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package terraform
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func TestLanguage_ParseExports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+
+	tests := []struct {
+		Name     string
+		File     string
+		Expected []language.ExportEntry
+	}{
+		{
+			Name: "root module",
+			File: absTestFolder,
+			Expected: []language.ExportEntry{{
+				Symbols: []language.ExportSymbol{{Original: "output.app_url"}},
+				AbsPath: absTestFolder,
+			}},
+		},
+		{
+			Name: "child module",
+			File: filepath.Join(absTestFolder, "modules", "app"),
+			Expected: []language.ExportEntry{{
+				Symbols: []language.ExportSymbol{{Original: "output.url"}},
+				AbsPath: filepath.Join(absTestFolder, "modules", "app"),
+			}},
+		},
+		{
+			Name:     "modules without outputs",
+			File:     filepath.Join(absTestFolder, "modules", "broken"),
+			Expected: []language.ExportEntry{},
+		},
+		{
+			Name:     "files",
+			File:     filepath.Join(absTestFolder, "outputs.tf"),
+			Expected: []language.ExportEntry{},
+		},
+		{
+			Name:     "external modules",
+			File:     filepath.Join(absTestFolder, externalDir, "hashicorp", "consul", "aws"),
+			Expected: []language.ExportEntry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeTerraformLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			exports, err := lang.ParseExports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, exports.Exports)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// externalDir is the virtual directory, relative to the project root, under which
+// modules that are not sourced from the local filesystem are placed.
+const externalDir = "@external"
+
+const externalPackage = "external"
+
+// isLocalSource returns whether a module source is a path in the local filesystem.
+// Anything else, like registry or git sources, is an external module.
+func isLocalSource(source string) bool {
+	return strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")
+}
+
+// externalId builds the id of the node representing an external module. External
+// modules are leaves in the graph, as their sources are not available locally.
+func externalId(root string, source string) string {
+	name := source
+	if i := strings.Index(name, "::"); i != -1 {
+		name = name[i+2:]
+	}
+	if i := strings.Index(name, "://"); i != -1 {
+		name = name[i+3:]
+	}
+	if i := strings.Index(name, "?"); i != -1 {
+		name = name[:i]
+	}
+	name = strings.ReplaceAll(name, "//", "/")
+	name = strings.ReplaceAll(name, ":", "/")
+	name = strings.Trim(name, "/")
+	return filepath.Join(root, externalDir, filepath.FromSlash(name))
+}
+
+// externalSource returns the name of the external module an id points to, if it
+// points to one.
+func externalSource(id string) (string, bool) {
+	sep := string(os.PathSeparator)
+	i := strings.Index(id, sep+externalDir+sep)
+	if i == -1 || utils.FileExists(id) {
+		return "", false
+	}
+	return filepath.ToSlash(id[i+len(externalDir)+2:]), true
+}
@@ -0,0 +1,58 @@
+package terraform
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/terraform/terraform_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+func (l *Language) ParseImports(file *language.FileInfo) (*language.ImportsResult, error) {
+	result := language.ImportsResult{Imports: make([]language.ImportEntry, 0)}
+	switch content := file.Content.(type) {
+	case *terraform_grammar.File:
+		// A file stands for the module where it lives, which uses all of it.
+		result.Imports = append(result.Imports, language.AllImport(filepath.Dir(file.AbsPath)))
+	case *Module:
+		content.imports(&result)
+	}
+	// External modules do not have any content.
+	return &result, nil
+}
+
+// imports gathers the modules called by module blocks. Local modules are imported
+// along with the outputs referenced from them, anything else is an external leaf.
+func (m *Module) imports(result *language.ImportsResult) {
+	root := projectRoot(m.AbsDir)
+	for _, file := range m.Files {
+		for _, block := range file.Content.(*terraform_grammar.File).Blocks() {
+			if block.Type != "module" {
+				continue
+			}
+			source := block.Attribute("source")
+			if source == nil {
+				continue
+			}
+			if !isLocalSource(*source) {
+				result.Imports = append(result.Imports, language.EmptyImport(externalId(root, *source)))
+				continue
+			}
+			moduleDir := filepath.Join(m.AbsDir, filepath.FromSlash(*source))
+			if !utils.DirExists(moduleDir) {
+				result.Errors = append(result.Errors, fmt.Errorf("could not resolve module source %q", *source))
+				continue
+			}
+			var outputs []string
+			if len(block.Labels) == 1 {
+				outputs = m.referencedOutputs(block.Labels[0])
+			}
+			if len(outputs) > 0 {
+				result.Imports = append(result.Imports, language.SymbolsImport(outputs, moduleDir))
+			} else {
+				result.Imports = append(result.Imports, language.EmptyImport(moduleDir))
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package terraform
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+const testFolder = ".terraform_test"
+
+func TestLanguage_ParseImports(t *testing.T) {
+	absTestFolder, _ := filepath.Abs(testFolder)
+	modules := filepath.Join(absTestFolder, "modules")
+
+	tests := []struct {
+		Name           string
+		File           string
+		Expected       []language.ImportEntry
+		ExpectedErrors []string
+	}{
+		{
+			Name: "files stand for their module",
+			File: filepath.Join(absTestFolder, "main.tf"),
+			Expected: []language.ImportEntry{
+				language.AllImport(absTestFolder),
+			},
+		},
+		{
+			Name: "root module",
+			File: absTestFolder,
+			Expected: []language.ImportEntry{
+				language.SymbolsImport([]string{"output.subnet_ids"}, filepath.Join(modules, "vpc")),
+				language.SymbolsImport([]string{"output.url"}, filepath.Join(modules, "app")),
+				language.EmptyImport(filepath.Join(absTestFolder, externalDir, "hashicorp", "consul", "aws")),
+				language.EmptyImport(filepath.Join(absTestFolder, externalDir, "example.com", "storage.git")),
+			},
+		},
+		{
+			Name: "modules without outputs",
+			File: filepath.Join(modules, "app"),
+			Expected: []language.ImportEntry{
+				language.EmptyImport(filepath.Join(modules, "broken")),
+			},
+			ExpectedErrors: []string{
+				"could not resolve module source \"../missing\"",
+			},
+		},
+		{
+			Name:     "modules that do not call other modules",
+			File:     filepath.Join(modules, "vpc"),
+			Expected: []language.ImportEntry{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeTerraformLanguage(nil)
+			a.NoError(err)
+
+			file, err := lang.ParseFile(tt.File)
+			a.NoError(err)
+
+			imports, err := lang.ParseImports(file)
+			a.NoError(err)
+			a.Equal(tt.Expected, imports.Imports)
+			var errs []string
+			for _, err := range imports.Errors {
+				errs = append(errs, err.Error())
+			}
+			a.Equal(tt.ExpectedErrors, errs)
+		})
+	}
+}
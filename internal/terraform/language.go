@@ -0,0 +1,78 @@
+package terraform
+
+import (
+	"path/filepath"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/terraform/terraform_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+var Extensions = []string{
+	"tf",
+}
+
+type Language struct {
+	cfg *Config
+}
+
+var _ language.Language = &Language{}
+var _ language.Invalidator = &Language{}
+
+func MakeTerraformLanguage(cfg *Config) (language.Language, error) {
+	lang := Language{
+		cfg: cfg,
+	}
+	if lang.cfg == nil {
+		lang.cfg = &Config{}
+	}
+	return &lang, nil
+}
+
+var parseTerraformFile, evictTerraformFile = utils.EvictableCached1In1OutErr(terraform_grammar.Parse)
+
+// ParseFile parses either a module, identified by its directory, or a single .tf
+// file, which only happens when it's an entrypoint, as files depend on the module
+// where they live and modules depend on other modules.
+func (l *Language) ParseFile(id string) (*language.FileInfo, error) {
+	if source, ok := externalSource(id); ok {
+		return &language.FileInfo{
+			AbsPath: id,
+			RelPath: source,
+			Package: externalPackage,
+		}, nil
+	}
+	if utils.DirExists(id) {
+		module, err := moduleInDir(id)
+		if err != nil {
+			return nil, err
+		}
+		pkg := modulePackage(projectRoot(id), id)
+		info := &language.FileInfo{
+			Content: module,
+			AbsPath: id,
+			RelPath: filepath.FromSlash(pkg),
+			Package: pkg,
+		}
+		for _, file := range module.Files {
+			info.Loc += file.Loc
+			info.Size += file.Size
+		}
+		return info, nil
+	}
+	parsed, err := parseTerraformFile(id)
+	if err != nil {
+		return nil, err
+	}
+	file := *parsed
+	root := projectRoot(filepath.Dir(id))
+	file.RelPath, _ = filepath.Rel(root, id)
+	file.Package = modulePackage(root, filepath.Dir(id))
+	return &file, nil
+}
+
+// Invalidate drops the provided files and the modules where they live.
+func (l *Language) Invalidate(absPaths ...string) {
+	evictTerraformFile(utils.IsAnyOf(absPaths))
+	evictModuleInDir(utils.IsDirOfAnyOf(absPaths))
+}
@@ -0,0 +1,94 @@
+package terraform
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+)
+
+func TestLanguage_ParseFile(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Path            string
+		ExpectedRelPath string
+		ExpectedPackage string
+	}{
+		{
+			Name:            "root module",
+			Path:            filepath.Join(testFolder, "main.tf"),
+			ExpectedRelPath: "main.tf",
+			ExpectedPackage: ".terraform_test",
+		},
+		{
+			Name:            "child module",
+			Path:            filepath.Join(testFolder, "modules", "vpc", "main.tf"),
+			ExpectedRelPath: "modules/vpc/main.tf",
+			ExpectedPackage: "modules/vpc",
+		},
+		{
+			Name:            "root module directory",
+			Path:            testFolder,
+			ExpectedRelPath: ".terraform_test",
+			ExpectedPackage: ".terraform_test",
+		},
+		{
+			Name:            "child module directory",
+			Path:            filepath.Join(testFolder, "modules", "vpc"),
+			ExpectedRelPath: filepath.Join("modules", "vpc"),
+			ExpectedPackage: "modules/vpc",
+		},
+		{
+			Name:            "external module",
+			Path:            filepath.Join(testFolder, externalDir, "hashicorp", "consul", "aws"),
+			ExpectedRelPath: "hashicorp/consul/aws",
+			ExpectedPackage: externalPackage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			lang, err := MakeTerraformLanguage(nil)
+			a.NoError(err)
+			absPath, _ := filepath.Abs(tt.Path)
+			file, err := lang.ParseFile(absPath)
+			a.NoError(err)
+			a.Equal(tt.ExpectedPackage, file.Package)
+			a.Equal(tt.ExpectedRelPath, file.RelPath)
+		})
+	}
+}
+
+func TestLanguage_Graph(t *testing.T) {
+	a := require.New(t)
+	lang, err := MakeTerraformLanguage(nil)
+	a.NoError(err)
+	absTestFolder, _ := filepath.Abs(testFolder)
+
+	g := graph.NewGraph[*language.FileInfo]()
+	err = g.Load([]string{filepath.Join(absTestFolder, "main.tf")}, language.NewParser(lang), nil)
+	a.NoError(err)
+
+	var edges []string
+	for _, node := range g.AllNodes() {
+		for _, child := range g.FromId(node.Id) {
+			edges = append(edges, node.Data.RelPath+" -> "+child.Data.RelPath)
+		}
+	}
+	slices.Sort(edges)
+	a.Equal([]string{
+		".terraform_test -> example.com/storage.git",
+		".terraform_test -> hashicorp/consul/aws",
+		".terraform_test -> " + filepath.Join("modules", "app"),
+		".terraform_test -> " + filepath.Join("modules", "vpc"),
+		"main.tf -> .terraform_test",
+		filepath.Join("modules", "app") + " -> " + filepath.Join("modules", "broken"),
+	}, edges)
+	// files of the same module reference each other, but modules have no cycles with themselves.
+	a.Empty(g.Tangles(1))
+}
@@ -0,0 +1,91 @@
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/terraform/terraform_grammar"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+// Module is a directory of .tf files. Terraform treats all the files in a
+// directory as a single unit, so any of them can reference what is declared
+// in the others, and each module is a single node in the graph.
+type Module struct {
+	AbsDir string
+	// Files are the parsed .tf files in the module, sorted by path.
+	Files []*language.FileInfo
+	// Outputs are the outputs declared in the module, named output.<name>. They are
+	// what the modules calling this one reference, like module.vpc.subnet_ids.
+	Outputs []string
+}
+
+func _moduleInDir(dir string) (*Module, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	module := Module{AbsDir: dir}
+	for _, entry := range entries {
+		if entry.IsDir() || !utils.EndsWith(entry.Name(), Extensions) {
+			continue
+		}
+		file, err := parseTerraformFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		module.Files = append(module.Files, file)
+		for _, block := range file.Content.(*terraform_grammar.File).Blocks() {
+			if block.Type == "output" && len(block.Labels) == 1 {
+				module.Outputs = append(module.Outputs, "output."+block.Labels[0])
+			}
+		}
+	}
+	return &module, nil
+}
+
+var moduleInDir, evictModuleInDir = utils.EvictableCached1In1OutErr(_moduleInDir)
+
+// referencedOutputs returns the outputs of the module called name that are
+// referenced from the module, like output.subnet_ids for module.vpc.subnet_ids.
+func (m *Module) referencedOutputs(name string) []string {
+	var result []string
+	for _, file := range m.Files {
+		for _, ref := range file.Content.(*terraform_grammar.File).References() {
+			segments := ref.Segments
+			if len(segments) < 3 || segments[0] != "module" || segments[1] != name {
+				continue
+			}
+			if output := "output." + segments[2]; !slices.Contains(result, output) {
+				result = append(result, output)
+			}
+		}
+	}
+	return result
+}
+
+var findProjectRoot = utils.MakeCachedFindClosestDirWithRootFile([]string{
+	".terraform.lock.hcl",
+	".git/index",
+})
+
+// projectRoot is the directory against which paths are made relative, which is
+// the closest one to dir with a lock file or a git repository, falling back to
+// dir itself.
+func projectRoot(dir string) string {
+	if root := findProjectRoot(dir); root != nil {
+		return root.AbsDir
+	}
+	return dir
+}
+
+// modulePackage names a module by its directory relative to the project root.
+func modulePackage(root string, dir string) string {
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == "." {
+		return filepath.Base(dir)
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,31 @@
+//nolint:govet
+package terraform_grammar
+
+// Block is any HCL block, like:
+//
+//	module "vpc" {
+//	  source = "./modules/vpc"
+//	}
+type Block struct {
+	Type   string       `@Ident`
+	Labels []string     `@(String | Ident)* "{"`
+	Body   []*Statement `(@@ | Operator | Punct | Number | ANY)* "}"`
+}
+
+// Attribute returns the value of a string attribute declared directly in the
+// block's body, or nil if there is none.
+func (b *Block) Attribute(name string) *string {
+	for _, stmt := range b.Body {
+		if stmt.Attribute != nil && stmt.Attribute.Name == name {
+			return stmt.Attribute.Value
+		}
+	}
+	return nil
+}
+
+// Attribute is the left side of an assignment. Only literal string values are
+// captured, everything else is parsed as regular statements.
+type Attribute struct {
+	Name  string  `@Ident "="`
+	Value *string `@String?`
+}
@@ -0,0 +1,105 @@
+//nolint:govet
+package terraform_grammar
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+type Statement struct {
+	Block     *Block     `  @@`
+	Attribute *Attribute `| @@`
+	Reference *Reference `| @@`
+	// Object swallows anything between braces that is not a block body, like
+	// object expressions or for expressions, so that braces are always balanced.
+	Object *Object `| @@`
+	String *string `| @String`
+}
+
+type Object struct {
+	Statements []*Statement `"{" (@@ | Operator | Punct | Number | ANY)* "}"`
+}
+
+type File struct {
+	Statements []*Statement `(@@ | Operator | Punct | Number | ANY | Brace)*`
+}
+
+var (
+	lex = lexer.MustSimple(
+		[]lexer.SimpleRule{
+			{"Comment", `#.*|//.*|/\*(.|\n)*?\*/`},
+			{"String", `"(?:\\.|\$\{[^}]*\}|%\{[^}]*\}|[^"\\])*"`},
+			{"Ident", `[_a-zA-Z][_a-zA-Z0-9\-]*`},
+			{"Number", `[0-9]+(?:\.[0-9]+)?(?:[eE][+\-]?[0-9]+)?`},
+			{"Brace", `[{}]`},
+			{"Operator", `==|!=|<=|>=|&&|\|\||=>|\.\.\.`},
+			{"Punct", `[=.,:?!<>+\-*/%()\[\]]`},
+			{"Whitespace", `\s+`},
+			{"ANY", `.`},
+		},
+	)
+	parser = participle.MustBuild[File](
+		participle.Lexer(lex),
+		participle.Elide("Whitespace", "Comment"),
+		utils.UnquoteSafe("String"),
+		participle.UseLookahead(1024),
+	)
+)
+
+// Blocks returns the top level blocks of the file.
+func (f *File) Blocks() []*Block {
+	var result []*Block
+	for _, stmt := range f.Statements {
+		if stmt.Block != nil {
+			result = append(result, stmt.Block)
+		}
+	}
+	return result
+}
+
+// References returns all the references made in the file, including the ones
+// interpolated in strings, in order of appearance.
+func (f *File) References() []Reference {
+	var result []Reference
+	collectReferences(f.Statements, &result)
+	return result
+}
+
+func collectReferences(stmts []*Statement, acc *[]Reference) {
+	for _, stmt := range stmts {
+		switch {
+		case stmt.Block != nil:
+			collectReferences(stmt.Block.Body, acc)
+		case stmt.Attribute != nil && stmt.Attribute.Value != nil:
+			*acc = append(*acc, interpolatedReferences(*stmt.Attribute.Value)...)
+		case stmt.Reference != nil:
+			*acc = append(*acc, *stmt.Reference)
+		case stmt.Object != nil:
+			collectReferences(stmt.Object.Statements, acc)
+		case stmt.String != nil:
+			*acc = append(*acc, interpolatedReferences(*stmt.String)...)
+		}
+	}
+}
+
+func Parse(filePath string) (*language.FileInfo, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseBytes(filePath, replaceHeredocs(content))
+	if err != nil {
+		return nil, err
+	}
+	return &language.FileInfo{
+		Content: file,
+		Loc:     bytes.Count(content, []byte("\n")),
+		Size:    len(content),
+		AbsPath: filePath,
+	}, nil
+}
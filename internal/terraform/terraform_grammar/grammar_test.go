@@ -0,0 +1,76 @@
+package terraform_grammar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		Name               string
+		ExpectedBlocks     []string
+		ExpectedSources    []string
+		ExpectedReferences []string
+	}{
+		{
+			Name:            "module \"vpc\" {\n  source  = \"./modules/vpc\"\n  version = \"~> 1.0\"\n}",
+			ExpectedBlocks:  []string{"module vpc"},
+			ExpectedSources: []string{"./modules/vpc"},
+		},
+		{
+			Name:               "resource \"aws_instance\" \"web\" {\n  ami = var.ami\n  subnet_id = module.vpc.subnet_ids[0]\n  tags = {\n    Name = \"${local.prefix}-web\"\n  }\n}",
+			ExpectedBlocks:     []string{"resource aws_instance web"},
+			ExpectedReferences: []string{"var.ami", "module.vpc.subnet_ids", "local.prefix"},
+		},
+		{
+			Name:               "locals {\n  ids = [for s in data.aws_subnet.all : s.id if s.id != \"\"]\n  count = length(var.list) >= 2 ? 1 : 0\n}",
+			ExpectedBlocks:     []string{"locals"},
+			ExpectedReferences: []string{"for", "s", "in", "data.aws_subnet.all", "s.id", "if", "s.id", "length", "var.list"},
+		},
+		{
+			Name:               "# module \"commented\" {}\n// module \"also\" {}\n/* module \"too\" {} */\noutput \"id\" {\n  value = aws_instance.web.0.id\n}",
+			ExpectedBlocks:     []string{"output id"},
+			ExpectedReferences: []string{"aws_instance.web.0.id"},
+		},
+		{
+			Name:               "resource \"null_resource\" \"x\" {\n  user_data = <<-EOT\n    #!/bin/bash\n    echo \"${var.name} }\"\n  EOT\n}\nmodule \"after\" {\n  source = \"../after\"\n}",
+			ExpectedBlocks:     []string{"resource null_resource x", "module after"},
+			ExpectedSources:    []string{"../after"},
+			ExpectedReferences: []string{"var.name"},
+		},
+		{
+			Name:               "dynamic \"setting\" {\n  for_each = var.settings\n  content {\n    name = setting.value[\"name\"]\n  }\n}",
+			ExpectedBlocks:     []string{"dynamic setting"},
+			ExpectedReferences: []string{"var.settings", "setting.value"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			parsed, err := parser.ParseBytes("", replaceHeredocs([]byte(tt.Name)))
+			a.NoError(err)
+
+			var blocks []string
+			var sources []string
+			for _, block := range parsed.Blocks() {
+				name := block.Type
+				for _, label := range block.Labels {
+					name += " " + label
+				}
+				blocks = append(blocks, name)
+				if source := block.Attribute("source"); source != nil {
+					sources = append(sources, *source)
+				}
+			}
+			var references []string
+			for _, ref := range parsed.References() {
+				references = append(references, ref.String())
+			}
+			a.Equal(tt.ExpectedBlocks, blocks)
+			a.Equal(tt.ExpectedSources, sources)
+			a.Equal(tt.ExpectedReferences, references)
+		})
+	}
+}
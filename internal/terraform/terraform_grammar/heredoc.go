@@ -0,0 +1,49 @@
+package terraform_grammar
+
+import (
+	"bytes"
+	"regexp"
+)
+
+var heredocStartRegex = regexp.MustCompile(`<<-?([_a-zA-Z][_a-zA-Z0-9]*)\r?\n`)
+
+// replaceHeredocs turns heredocs into regular string literals, as the lexer is not
+// able to match their closing marker. Newlines are kept so that positions in the
+// file are preserved, and quotes are replaced so that the string is not closed early.
+func replaceHeredocs(content []byte) []byte {
+	var result []byte
+	for {
+		loc := heredocStartRegex.FindSubmatchIndex(content)
+		if loc == nil {
+			return append(result, content...)
+		}
+		marker := content[loc[2]:loc[3]]
+		body := content[loc[1]:]
+		end := -1
+		for i := 0; i < len(body); {
+			lineEnd := bytes.IndexByte(body[i:], '\n')
+			if lineEnd == -1 {
+				lineEnd = len(body) - i
+			}
+			if bytes.Equal(bytes.TrimSpace(body[i:i+lineEnd]), marker) {
+				end = i
+				break
+			}
+			i += lineEnd + 1
+		}
+		if end == -1 {
+			return append(result, content...)
+		}
+		result = append(result, content[:loc[0]]...)
+		result = append(result, '"', '\n')
+		for _, c := range body[:end] {
+			switch c {
+			case '"', '\\':
+				c = '\''
+			}
+			result = append(result, c)
+		}
+		result = append(result, '"')
+		content = body[end+bytes.Index(body[end:], marker)+len(marker):]
+	}
+}
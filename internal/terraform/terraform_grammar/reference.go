@@ -0,0 +1,33 @@
+//nolint:govet
+package terraform_grammar
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Reference is a traversal like var.region, module.vpc.id or aws_instance.web.
+type Reference struct {
+	Segments []string `@Ident ("." @(Ident | Number | "*"))*`
+}
+
+func (r Reference) String() string {
+	return strings.Join(r.Segments, ".")
+}
+
+var (
+	interpolationRegex = regexp.MustCompile(`[$%]\{([^}]*)}`)
+	traversalRegex     = regexp.MustCompile(`[_a-zA-Z][_a-zA-Z0-9\-]*(?:\.(?:[_a-zA-Z][_a-zA-Z0-9\-]*|[0-9]+|\*))*`)
+)
+
+// interpolatedReferences returns the references made inside ${...} and %{...}
+// sequences of a string.
+func interpolatedReferences(str string) []Reference {
+	var result []Reference
+	for _, match := range interpolationRegex.FindAllStringSubmatch(str, -1) {
+		for _, traversal := range traversalRegex.FindAllString(match[1], -1) {
+			result = append(result, Reference{Segments: strings.Split(traversal, ".")})
+		}
+	}
+	return result
+}
@@ -41,14 +41,23 @@ func NewTree[T any](
 	if err != nil {
 		return nil, err
 	}
-	entrypoint, err := parser.Node(files[0])
-	if err != nil {
-		return nil, err
-	}
+	return NewTreeFromGraph(g, files[0], parser, display)
+}
+
+// NewTreeFromGraph builds the tree from an already loaded graph. The graph is not
+// modified, so it can be reused for building the tree again after it's refreshed.
+func NewTreeFromGraph[T any](
+	g *graph.Graph[T],
+	file string,
+	parser graph.NodeParser[T],
+	display func(node *graph.Node[T]) string,
+) (*Tree[T], error) {
+	entrypoint := g.Get(file)
 	if entrypoint == nil {
-		return nil, fmt.Errorf("selected entrypoint %s is explicitly ignored", files[0])
+		return nil, fmt.Errorf("selected entrypoint %s is explicitly ignored", file)
 	}
 
+	g = g.Clone()
 	cycles := g.RemoveCyclesStartingFromNode(entrypoint)
 
 	allNodes := g.AllNodes()
@@ -0,0 +1,159 @@
+package unused
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	golang "github.com/gabotechs/dep-tree/internal/go"
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/utils"
+)
+
+type Config struct {
+	// Files are glob patterns that match all the source files of the project.
+	Files []string `yaml:"files"`
+	// Allow are glob patterns that match files which are never reported as unused, like
+	// the ones that are loaded dynamically. They are treated as additional entrypoints.
+	Allow []string `yaml:"allow"`
+}
+
+func (c *Config) EnsureAbsPaths(path string) {
+	for i, pattern := range c.Files {
+		if !filepath.IsAbs(pattern) {
+			c.Files[i] = filepath.Join(path, pattern)
+		}
+	}
+	for i, pattern := range c.Allow {
+		if !filepath.IsAbs(pattern) {
+			c.Allow[i] = filepath.Join(path, pattern)
+		}
+	}
+}
+
+func (c *Config) allowed(file string) bool {
+	for _, pattern := range c.Allow {
+		if ok, _ := utils.GlobstarMatch(pattern, file); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Exports are the symbols exported by a file that no other file imports.
+type Exports struct {
+	File    string
+	Symbols []string
+}
+
+type Result struct {
+	// Files are the source files that are not reachable from any of the entrypoints.
+	Files []string
+	// Exports are the unused exports of the files that are reachable from the entrypoints.
+	Exports []Exports
+}
+
+// Find loads the graph starting from the entrypoints and from the allowed files, and reports
+// the source files that are not part of it, along with the symbols exported by the files in
+// it that no other file imports. The exports of the entrypoints and of the allowed files are
+// not reported, as they are meant to be used from outside the graph.
+//
+// Go files are reachable if any file of their package is: methods can be declared in a
+// different file than their type, and as they are called through values of that type,
+// nothing imports the files that only declare methods. Likewise, files are reachable if their
+// directory is in the graph, as languages like Terraform load a whole directory as a single node.
+func Find(
+	parser *language.Parser,
+	entrypoints []string,
+	files []string,
+	cfg *Config,
+	display func(node *graph.Node[*language.FileInfo]) string,
+	callbacks graph.LoadCallbacks[*language.FileInfo],
+) (*Result, error) {
+	roots := slices.Clone(entrypoints)
+	for _, file := range files {
+		if cfg.allowed(file) && !slices.Contains(roots, file) {
+			roots = append(roots, file)
+		}
+	}
+
+	g := graph.NewGraph[*language.FileInfo]()
+	if err := g.Load(roots, parser, callbacks); err != nil {
+		return nil, err
+	}
+
+	goPackages := map[string]bool{}
+	for _, node := range g.AllNodes() {
+		if isGo(node.Id) {
+			goPackages[filepath.Dir(node.Id)] = true
+		}
+	}
+
+	result := &Result{}
+	for _, file := range files {
+		if g.Has(file) || g.Has(filepath.Dir(file)) || parser.ShouldExclude(file) || (isGo(file) && goPackages[filepath.Dir(file)]) {
+			continue
+		}
+		// unreachable files are parsed only for displaying them like the rest.
+		if node, err := parser.Node(file); err == nil && node != nil {
+			result.Files = append(result.Files, display(node))
+		} else {
+			result.Files = append(result.Files, file)
+		}
+	}
+
+	for _, node := range g.AllNodes() {
+		// a directory node standing for the files of an entrypoint is an entrypoint too.
+		if slices.Contains(roots, node.Id) || slices.ContainsFunc(roots, func(root string) bool {
+			return filepath.Dir(root) == node.Id
+		}) {
+			continue
+		}
+		symbols, err := parser.UnusedExports(g, node.Id)
+		if err != nil {
+			return nil, err
+		}
+		if len(symbols) > 0 {
+			result.Exports = append(result.Exports, Exports{File: display(node), Symbols: symbols})
+		}
+	}
+
+	slices.Sort(result.Files)
+	slices.SortFunc(result.Exports, func(a, b Exports) int {
+		return strings.Compare(a.File, b.File)
+	})
+	return result, nil
+}
+
+func (r *Result) Empty() bool {
+	return len(r.Files) == 0 && len(r.Exports) == 0
+}
+
+func (r *Result) Render() string {
+	var sb strings.Builder
+	if len(r.Files) > 0 {
+		sb.WriteString(utils.Plural(len(r.Files), "file is", "files are") + " not reachable from the entrypoints:\n")
+		for _, file := range r.Files {
+			sb.WriteString("- " + file + "\n")
+		}
+	}
+	if len(r.Exports) > 0 {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		symbols := 0
+		for _, exports := range r.Exports {
+			symbols += len(exports.Symbols)
+		}
+		sb.WriteString(utils.Plural(symbols, "exported symbol is", "exported symbols are") + " not imported by any file:\n")
+		for _, exports := range r.Exports {
+			sb.WriteString("- " + exports.File + ": " + strings.Join(exports.Symbols, ", ") + "\n")
+		}
+	}
+	return sb.String()
+}
+
+func isGo(file string) bool {
+	return slices.Contains(golang.Extensions, strings.TrimPrefix(filepath.Ext(file), "."))
+}
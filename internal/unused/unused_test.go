@@ -0,0 +1,142 @@
+package unused
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	golang "github.com/gabotechs/dep-tree/internal/go"
+	"github.com/gabotechs/dep-tree/internal/graph"
+	"github.com/gabotechs/dep-tree/internal/language"
+	"github.com/gabotechs/dep-tree/internal/terraform"
+)
+
+func TestResult_Render(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Result   Result
+		Expected string
+	}{
+		{
+			Name:   "Nothing unused",
+			Result: Result{},
+		},
+		{
+			Name:   "Only files",
+			Result: Result{Files: []string{"a.ts"}},
+			Expected: `1 file is not reachable from the entrypoints:
+- a.ts
+`,
+		},
+		{
+			Name: "Files and exports",
+			Result: Result{
+				Files:   []string{"a.ts", "b.ts"},
+				Exports: []Exports{{File: "c.ts", Symbols: []string{"foo", "bar"}}, {File: "d.ts", Symbols: []string{"baz"}}},
+			},
+			Expected: `2 files are not reachable from the entrypoints:
+- a.ts
+- b.ts
+
+3 exported symbols are not imported by any file:
+- c.ts: foo, bar
+- d.ts: baz
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := require.New(t)
+			a.Equal(tt.Expected == "", tt.Result.Empty())
+			a.Equal(tt.Expected, tt.Result.Render())
+		})
+	}
+}
+
+func TestConfig_allowed(t *testing.T) {
+	a := require.New(t)
+	cfg := Config{Allow: []string{"src/plugins/*.ts"}}
+	cfg.EnsureAbsPaths("/project")
+
+	a.True(cfg.allowed("/project/src/plugins/foo.ts"))
+	a.False(cfg.allowed("/project/src/plugins/nested/foo.ts"))
+	a.False(cfg.allowed("/project/src/foo.ts"))
+}
+
+func TestFind_Go(t *testing.T) {
+	a := require.New(t)
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":         "module example\n\ngo 1.21\n",
+		"main.go":        "package main\n\nimport \"example/pkg\"\n\nfunc main() {\n\tvar t *pkg.T = pkg.New()\n\tt.Run()\n}\n",
+		"pkg/t.go":       "package pkg\n\ntype T struct{}\n\nfunc New() *T { return &T{} }\n\nfunc Unused() {}\n\nvar _hidden = 1\n",
+		"pkg/methods.go": "package pkg\n\nfunc (t *T) Run() {}\n",
+		"pkg/dead.go":    "package pkg\n\nfunc dead() {}\n",
+		"orphan/a.go":    "package orphan\n\nfunc A() {}\n",
+		"orphan/b.go":    "package orphan\n\nfunc B() {}\n",
+	}
+	var paths []string
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		a.NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+		a.NoError(os.WriteFile(path, []byte(content), 0o600))
+		if filepath.Ext(name) == ".go" {
+			paths = append(paths, path)
+		}
+	}
+
+	lang, err := golang.NewLanguage(dir, &golang.Config{})
+	a.NoError(err)
+	result, err := Find(
+		language.NewParser(lang),
+		[]string{filepath.Join(dir, "main.go")},
+		paths,
+		&Config{},
+		func(node *graph.Node[*language.FileInfo]) string { return node.Data.RelPath },
+		nil,
+	)
+	a.NoError(err)
+	// files that only declare methods are not imported by anyone, so Go files are only
+	// reported if no file of their package is reachable.
+	a.Equal([]string{"orphan/a.go", "orphan/b.go"}, result.Files)
+	a.Equal([]Exports{{File: "pkg/t.go", Symbols: []string{"Unused"}}}, result.Exports)
+}
+
+func TestFind_Terraform(t *testing.T) {
+	a := require.New(t)
+	dir := t.TempDir()
+	files := map[string]string{
+		".terraform.lock.hcl":    "",
+		"main.tf":                "module \"net\" {\n  source = \"./modules/net\"\n}\n\noutput \"id\" {\n  value = module.net.id\n}\n",
+		"variables.tf":           "variable \"name\" {}\n",
+		"modules/net/main.tf":    "output \"id\" {\n  value = 1\n}\n\noutput \"extra\" {\n  value = 2\n}\n",
+		"modules/orphan/main.tf": "output \"id\" {\n  value = 1\n}\n",
+	}
+	var paths []string
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		a.NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+		a.NoError(os.WriteFile(path, []byte(content), 0o600))
+		if filepath.Ext(name) == ".tf" {
+			paths = append(paths, path)
+		}
+	}
+
+	lang, err := terraform.MakeTerraformLanguage(nil)
+	a.NoError(err)
+	result, err := Find(
+		language.NewParser(lang),
+		[]string{filepath.Join(dir, "main.tf")},
+		paths,
+		&Config{},
+		func(node *graph.Node[*language.FileInfo]) string { return node.Data.RelPath },
+		nil,
+	)
+	a.NoError(err)
+	// modules are loaded as a single node, so their files are reachable through it.
+	a.Equal([]string{filepath.Join("modules", "orphan", "main.tf")}, result.Files)
+	a.Equal([]Exports{{File: filepath.Join("modules", "net"), Symbols: []string{"output.extra"}}}, result.Exports)
+}
@@ -1,14 +1,65 @@
 package utils
 
+import "sync"
+
+// syncCache is a map that can be safely accessed from multiple goroutines.
+// Values are computed outside the lock, so cached functions can call themselves
+// recursively. If two goroutines compute the same key at the same time, the
+// first value that gets stored wins, and both of them return it.
+type syncCache[K comparable, V any] struct {
+	mu    sync.RWMutex
+	cache map[K]V
+}
+
+func newSyncCache[K comparable, V any]() *syncCache[K, V] {
+	return &syncCache[K, V]{cache: make(map[K]V)}
+}
+
+func (c *syncCache[K, V]) get(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	value, ok := c.cache[key]
+	return value, ok
+}
+
+func (c *syncCache[K, V]) set(key K, value V) V {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, ok := c.cache[key]; ok {
+		return existing
+	}
+	c.cache[key] = value
+	return value
+}
+
+func (c *syncCache[K, V]) evict(match func(K) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.cache {
+		if match(key) {
+			delete(c.cache, key)
+		}
+	}
+}
+
+// Evict drops the values cached for the inputs that match, so that they are computed again.
+type Evict[I any] func(match func(I) bool)
+
 func Cached1In1Out[I comparable, O any](f func(I) O) func(I) O {
-	cache := make(map[I]O)
+	cached, _ := EvictableCached1In1Out(f)
+	return cached
+}
+
+// EvictableCached1In1Out is the same as Cached1In1Out, but it also returns a function
+// for dropping some of the cached values.
+func EvictableCached1In1Out[I comparable, O any](f func(I) O) (func(I) O, Evict[I]) {
+	cache := newSyncCache[I, O]()
 	return func(x I) O {
-		if _, ok := cache[x]; !ok {
-			cache[x] = f(x)
+		if value, ok := cache.get(x); ok {
+			return value
 		}
-		value, _ := cache[x]
-		return value
-	}
+		return cache.set(x, f(x))
+	}, cache.evict
 }
 
 type in2[I1 comparable, I2 comparable] struct {
@@ -17,34 +68,39 @@ type in2[I1 comparable, I2 comparable] struct {
 }
 
 func Cached2In1OutErr[I1 comparable, I2 comparable, O1 any](f func(I1, I2) (O1, error)) func(I1, I2) (O1, error) {
-	cache := make(map[in2[I1, I2]]O1)
+	cache := newSyncCache[in2[I1, I2], O1]()
 	return func(i1 I1, i2 I2) (O1, error) {
 		key := in2[I1, I2]{i1, i2}
-		if _, ok := cache[key]; !ok {
-			o1, err := f(i1, i2)
-			if err != nil {
-				return o1, err
-			}
-			cache[key] = o1
+		if value, ok := cache.get(key); ok {
+			return value, nil
 		}
-		value, _ := cache[key]
-		return value, nil
+		o1, err := f(i1, i2)
+		if err != nil {
+			return o1, err
+		}
+		return cache.set(key, o1), nil
 	}
 }
 
 func Cached1In1OutErr[I comparable, O1 any](f func(I) (O1, error)) func(I) (O1, error) {
-	cache := make(map[I]O1)
+	cached, _ := EvictableCached1In1OutErr(f)
+	return cached
+}
+
+// EvictableCached1In1OutErr is the same as Cached1In1OutErr, but it also returns a function
+// for dropping some of the cached values.
+func EvictableCached1In1OutErr[I comparable, O1 any](f func(I) (O1, error)) (func(I) (O1, error), Evict[I]) {
+	cache := newSyncCache[I, O1]()
 	return func(x I) (O1, error) {
-		if _, ok := cache[x]; !ok {
-			o1, err := f(x)
-			if err != nil {
-				return o1, err
-			}
-			cache[x] = o1
+		if value, ok := cache.get(x); ok {
+			return value, nil
 		}
-		value, _ := cache[x]
-		return value, nil
-	}
+		o1, err := f(x)
+		if err != nil {
+			return o1, err
+		}
+		return cache.set(x, o1), nil
+	}, cache.evict
 }
 
 type out2[O1 any, O2 any] struct {
@@ -53,28 +109,35 @@ type out2[O1 any, O2 any] struct {
 }
 
 func Cached1In2OutErr[I comparable, O1 any, O2 any](f func(I) (O1, O2, error)) func(I) (O1, O2, error) {
-	cache := make(map[I]out2[O1, O2])
+	cache := newSyncCache[I, out2[O1, O2]]()
 	return func(x I) (O1, O2, error) {
-		if _, ok := cache[x]; !ok {
-			o1, o2, err := f(x)
-			if err != nil {
-				return o1, o2, err
-			}
-			cache[x] = out2[O1, O2]{o1, o2}
+		if value, ok := cache.get(x); ok {
+			return value.o1, value.o2, nil
+		}
+		o1, o2, err := f(x)
+		if err != nil {
+			return o1, o2, err
 		}
-		value, _ := cache[x]
+		value := cache.set(x, out2[O1, O2]{o1, o2})
 		return value.o1, value.o2, nil
 	}
 }
 
 func Cached1In2Out[I comparable, O1 any, O2 any](f func(I) (O1, O2)) func(I) (O1, O2) {
-	cache := make(map[I]out2[O1, O2])
+	cached, _ := EvictableCached1In2Out(f)
+	return cached
+}
+
+// EvictableCached1In2Out is the same as Cached1In2Out, but it also returns a function
+// for dropping some of the cached values.
+func EvictableCached1In2Out[I comparable, O1 any, O2 any](f func(I) (O1, O2)) (func(I) (O1, O2), Evict[I]) {
+	cache := newSyncCache[I, out2[O1, O2]]()
 	return func(x I) (O1, O2) {
-		if _, ok := cache[x]; !ok {
-			o1, o2 := f(x)
-			cache[x] = out2[O1, O2]{o1, o2}
+		if value, ok := cache.get(x); ok {
+			return value.o1, value.o2
 		}
-		value, _ := cache[x]
+		o1, o2 := f(x)
+		value := cache.set(x, out2[O1, O2]{o1, o2})
 		return value.o1, value.o2
-	}
+	}, cache.evict
 }
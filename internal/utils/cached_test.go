@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCached1In1Out_Concurrent(t *testing.T) {
+	a := require.New(t)
+	var calls atomic.Int32
+	f := Cached1In1Out(func(x int) *int {
+		calls.Add(1)
+		return &x
+	})
+
+	results := make([]*int, 100)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = f(i % 10)
+		}(i)
+	}
+	wg.Wait()
+
+	for i, result := range results {
+		// every call with the same input returns the same pointer.
+		a.Same(results[i%10], result)
+		a.Equal(i%10, *result)
+	}
+	a.LessOrEqual(calls.Load(), int32(100))
+	a.GreaterOrEqual(calls.Load(), int32(10))
+}
+
+func TestCached1In1Out_Recursive(t *testing.T) {
+	a := require.New(t)
+	var calls int
+	var fib func(int) int
+	fib = Cached1In1Out(func(n int) int {
+		calls++
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+
+	a.Equal(6765, fib(20))
+	a.Equal(21, calls)
+}
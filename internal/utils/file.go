@@ -1,6 +1,11 @@
 package utils
 
-import "os"
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
 
 func _FileExists(path string) bool {
 	stat, err := os.Stat(path)
@@ -10,7 +15,7 @@ func _FileExists(path string) bool {
 	return !stat.IsDir()
 }
 
-var FileExists = Cached1In1Out(_FileExists)
+var FileExists, evictFileExists = EvictableCached1In1Out(_FileExists)
 
 func _DirExists(path string) bool {
 	stat, err := os.Stat(path)
@@ -20,4 +25,46 @@ func _DirExists(path string) bool {
 	return stat.IsDir()
 }
 
-var DirExists = Cached1In1Out(_DirExists)
+var DirExists, evictDirExists = EvictableCached1In1Out(_DirExists)
+
+// EvictExists drops what FileExists and DirExists know about the provided paths and
+// their directories, as they might have been created or removed.
+func EvictExists(paths ...string) {
+	evictFileExists(IsAnyOf(paths))
+	isDirOf := IsDirOfAnyOf(paths)
+	evictDirExists(func(path string) bool {
+		return slices.Contains(paths, path) || isDirOf(path)
+	})
+}
+
+// IsAnyOf matches the provided paths.
+func IsAnyOf(paths []string) func(path string) bool {
+	return func(path string) bool {
+		return slices.Contains(paths, path)
+	}
+}
+
+// IsDirOfAnyOf matches the directories that directly contain any of the provided paths.
+func IsDirOfAnyOf(paths []string) func(dir string) bool {
+	return func(dir string) bool {
+		for _, path := range paths {
+			if filepath.Dir(path) == dir {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ContainsAnyOf matches the directories that contain any of the provided paths, no
+// matter how deep they are.
+func ContainsAnyOf(paths []string) func(dir string) bool {
+	return func(dir string) bool {
+		for _, path := range paths {
+			if rel, err := filepath.Rel(dir, path); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,14 @@
+package utils
+
+// Map returns a new slice with the result of applying f to each element of arr,
+// or nil if arr is nil.
+func Map[T any, R any](arr []T, f func(T) R) []R {
+	if arr == nil {
+		return nil
+	}
+	result := make([]R, len(arr))
+	for i, el := range arr {
+		result[i] = f(el)
+	}
+	return result
+}
@@ -0,0 +1,11 @@
+package utils
+
+import "fmt"
+
+// Plural prefixes the amount to the singular or to the plural form, depending on the amount.
+func Plural(n int, singular, plural string) string {
+	if n == 1 {
+		return "1 " + singular
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
@@ -0,0 +1,94 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"sort"
+	"time"
+)
+
+// stamp is what identifies a version of a file. Files that do not exist have
+// a zero stamp.
+type stamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func stampOf(path string) stamp {
+	info, err := os.Stat(path)
+	if err != nil {
+		return stamp{}
+	}
+	return stamp{modTime: info.ModTime(), size: info.Size()}
+}
+
+// Watcher detects changes in files by polling them periodically, which works the
+// same in every platform and in every kind of filesystem.
+type Watcher struct {
+	Interval time.Duration
+	stamps   map[string]stamp
+}
+
+func NewWatcher(interval time.Duration) *Watcher {
+	return &Watcher{
+		Interval: interval,
+		stamps:   make(map[string]stamp),
+	}
+}
+
+// Watch starts watching the provided files, if they were not watched already.
+func (w *Watcher) Watch(paths ...string) {
+	for _, path := range paths {
+		if _, ok := w.stamps[path]; !ok {
+			w.stamps[path] = stampOf(path)
+		}
+	}
+}
+
+// Unwatch stops watching the files that are not in the provided ones.
+func (w *Watcher) Unwatch(keep ...string) {
+	keepSet := make(map[string]bool, len(keep))
+	for _, path := range keep {
+		keepSet[path] = true
+	}
+	for path := range w.stamps {
+		if !keepSet[path] {
+			delete(w.stamps, path)
+		}
+	}
+}
+
+// poll returns the sorted files that changed since the last time they were polled.
+func (w *Watcher) poll() []string {
+	var changed []string
+	for path, prev := range w.stamps {
+		if current := stampOf(path); current != prev {
+			w.stamps[path] = current
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// Wait blocks until some of the watched files change, and returns them. Changes
+// that happen in quick succession, like the ones made by a formatter right after
+// saving a file, are returned together.
+func (w *Watcher) Wait(ctx context.Context) ([]string, error) {
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	var changed []string
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+		current := w.poll()
+		if len(current) == 0 && len(changed) > 0 {
+			return changed, nil
+		}
+		changed = append(changed, current...)
+	}
+}
@@ -0,0 +1,37 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher(t *testing.T) {
+	a := require.New(t)
+	dir := t.TempDir()
+	foo, bar, baz := filepath.Join(dir, "foo"), filepath.Join(dir, "bar"), filepath.Join(dir, "baz")
+	a.NoError(os.WriteFile(foo, []byte("foo"), 0o600))
+	a.NoError(os.WriteFile(bar, []byte("bar"), 0o600))
+
+	w := NewWatcher(10 * time.Millisecond)
+	w.Watch(foo, bar, baz)
+
+	a.NoError(os.WriteFile(foo, []byte("changed"), 0o600))
+	a.NoError(os.Remove(bar))
+	a.NoError(os.WriteFile(baz, []byte("created"), 0o600))
+
+	changed, err := w.Wait(context.Background())
+	a.NoError(err)
+	a.Equal([]string{bar, baz, foo}, changed)
+
+	w.Unwatch(foo)
+	a.NoError(os.WriteFile(baz, []byte("not watched"), 0o600))
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = w.Wait(ctx)
+	a.ErrorIs(err, context.DeadlineExceeded)
+}
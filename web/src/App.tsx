@@ -90,6 +90,8 @@ function GraphExplorer({ graphData }: { graphData: Graph }) {
     let alpha = settings.LINK_ALPHA
     if (highlightLinks.size > 0 && !highlightLinks.has(link)) alpha = settings.UNSELECTED_LINK_ALPHA
     if (link.ignore) alpha = settings.IGNORED_LINK_ALPHA
+    if (link.diff === 'added') return `limegreen`;
+    if (link.diff === 'removed') return `orange`;
     if (link.isCyclic && settings.HIGHLIGHT_CYCLES) return `indianred`;
     return `rgba(255, 255, 255, ${alpha})`;
   }
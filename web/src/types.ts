@@ -17,6 +17,16 @@ export interface Link {
   from: number /* int64 */;
   to: number /* int64 */;
   isCyclic: boolean;
+  /**
+   * Weight is the amount of dependencies between files that the link stands for
+   * when files are collapsed into directories or packages.
+   */
+  weight?: number /* int */;
+  /**
+   * Diff is "added" or "removed" when rendering the changes between two revisions,
+   * and empty if the link did not change.
+   */
+  diff?: string;
 }
 export interface Graph {
   nodes: Node[];